@@ -41,6 +41,11 @@ const (
 	royalFlush    = 67108864
 )
 
+const (
+	rankBits   = 4  // bits for single rank in score
+	scoreShift = 20 // bits for five ranks in score
+)
+
 // Combination describe combination of user
 type Combination struct {
 	Combination   int
@@ -50,9 +55,43 @@ type Combination struct {
 	Kickers       []int // from 0 to 4
 }
 
-// Score calculate score of combination
+// Score calculate score of combination, higher score is better hand, equal scores are split
 func (c *Combination) Score() int {
-	return c.Combination | c.Weight | c.KickersWeight
+	return c.Combination<<scoreShift | c.ranksScore()
+}
+
+// ranksScore pack ranks of combination in order of significance: groups by size, then kickers
+func (c *Combination) ranksScore() int {
+	if c.Combination == straight || c.Combination == straightFlush || c.Combination == royalFlush {
+		return rankIndex(straightTop(c.Cards)) + 1
+	}
+
+	var counts [13]int
+	for _, card := range c.Cards {
+		counts[rankIndex(card)]++
+	}
+
+	var score, n int
+
+	for size := 4; size > 0; size-- {
+		for r := len(counts) - 1; r >= 0; r-- {
+			if counts[r] == size {
+				score = score<<rankBits | (r + 1)
+				n++
+			}
+		}
+	}
+
+	for i := len(c.Kickers) - 1; i >= 0; i-- {
+		score = score<<rankBits | (rankIndex(c.Kickers[i]) + 1)
+		n++
+	}
+
+	for ; n < 5; n++ { //nolint:mnd
+		score <<= rankBits
+	}
+
+	return score
 }
 
 // CalculateKicker calculate combination kicker
@@ -93,6 +132,40 @@ func cardsWeight(res []int) int {
 	return weight
 }
 
+// rankIndex return index of card rank from 0 (deuce) to 12 (ace)
+func rankIndex(c int) int {
+	r := (c & rank) >> 5 //nolint:mnd
+	i := 0
+
+	for r != 0 {
+		r >>= 1
+		i++
+	}
+
+	return i
+}
+
+// straightTop return highest card of straight, five for wheel (A-2-3-4-5)
+func straightTop(cards []int) int {
+	var top int
+
+	for _, c := range cards {
+		if c&rank > top&rank {
+			top = c
+		}
+	}
+
+	if top&cardA != 0 && cardsWeight(cards)&card2 != 0 {
+		for _, c := range cards {
+			if c&card5 != 0 {
+				return c
+			}
+		}
+	}
+
+	return top
+}
+
 // BinaryEvaluation evaluation by binary cards
 type BinaryEvaluation struct{}
 
@@ -150,167 +223,153 @@ func (b BinaryEvaluation) Execute(cards []int) *Combination {
 	return combination
 }
 
-func (b BinaryEvaluation) straightFlush(cards []int) *Combination {
-	ranks := map[int]map[int][]int{}
-	var lastRank int
-	id := 0
+// groupByRank return cards grouped by rank and ranks sorted from highest
+func groupByRank(cards []int) (map[int][]int, []int) {
+	ranks := map[int][]int{}
+
+	var order []int
 
 	for _, c := range cards {
-		if lastRank == c&rank {
-			continue
+		r := c & rank
+		if _, e := ranks[r]; !e {
+			order = append(order, r)
 		}
 
-		if lastRank != 0 && lastRank<<1 != c&rank {
-			id++
-		}
+		ranks[r] = append(ranks[r], c)
+	}
 
-		if _, e := ranks[id]; !e {
-			ranks[id] = map[int][]int{}
-		}
+	sort.Slice(order, func(i, j int) bool {
+		return order[i] > order[j]
+	})
 
-		ranks[id][c&suit] = append(ranks[id][c&suit], c)
-		lastRank = c & rank
+	return ranks, order
+}
+
+// findStraight return five cards of the highest straight, cards must be sorted
+func findStraight(cards []int) []int {
+	byRank := map[int]int{}
+	for _, c := range cards {
+		byRank[c&rank] = c
 	}
 
-	ace := cards[len(cards)-1]&cardA != 0
-	combination := &Combination{}
+	for top := cardA; top >= card6; top >>= 1 {
+		res := make([]int, 0, 5) //nolint:mnd
 
-	for _, suits := range ranks {
-		for _, res := range suits {
-			if len(res) > 5 { //nolint:mnd
-				res = res[len(res)-5:]
+		for r := top >> 4; r <= top; r <<= 1 { //nolint:mnd
+			c, e := byRank[r]
+			if !e {
+				break
 			}
 
-			if ace && len(res) == 4 && res[0]&card2 != 0 { //nolint:mnd
-				res = append(res, cards[len(cards)-1])
-
-				weight := cardsWeight(res)
-				if weight > combination.Weight {
-					combination.Combination = straightFlush
-					combination.Weight = weight
-					combination.KickersWeight = 0
-					combination.Kickers = []int{}
-					combination.Cards = res
-				}
-			} else if len(res) == 5 { //nolint:mnd
-				weight := cardsWeight(res)
-				if weight > combination.Weight {
-					if res[len(res)-1]&cardA != 0 {
-						combination.Combination = royalFlush
-					} else {
-						combination.Combination = straightFlush
-					}
-					combination.Weight = weight
-					combination.KickersWeight = 0
-					combination.Kickers = []int{}
-					combination.Cards = res
-				}
-			}
+			res = append(res, c)
 		}
-	}
 
-	return combination
-}
-
-func (b BinaryEvaluation) fourOfAKind(cards []int) *Combination {
-	ranks := map[int][]int{}
-	cardSet := set.NewGenericDataSet[int]()
+		if len(res) == 5 { //nolint:mnd
+			return res
+		}
+	}
 
-	for _, c := range cards {
-		r := c & rank
-		ranks[r] = append(ranks[r], c)
-		cardSet.Add(c)
+	ace, e := byRank[cardA]
+	if !e {
+		return nil
 	}
 
-	combination := &Combination{}
+	res := []int{ace}
 
-	for _, res := range ranks {
-		if len(res) == 4 { //nolint:mnd
-			weight := cardsWeight(res)
-			if weight > combination.Weight {
-				combination.Combination = fourOfAKind
-				combination.Weight = weight
-				combination.KickersWeight = 0
-				combination.Kickers = []int{}
-				combination.Cards = res
-			}
+	for r := card2; r <= card5; r <<= 1 {
+		c, e := byRank[r]
+		if !e {
+			return nil
 		}
-	}
 
-	combination.CalculateKicker(cardSet)
+		res = append(res, c)
+	}
 
-	return combination
+	return res
 }
 
-func (b BinaryEvaluation) fullHouse(cards []int) *Combination {
-	ranks := map[int][]int{}
-	cardSet := set.NewGenericDataSet[int]()
-
+func (b BinaryEvaluation) straightFlush(cards []int) *Combination {
+	suits := map[int][]int{}
 	for _, c := range cards {
-		r := c & rank
-		ranks[r] = append(ranks[r], c)
-		cardSet.Add(c)
+		suits[c&suit] = append(suits[c&suit], c)
 	}
 
-	var two [][]int
-	var three [][]int
+	combination := &Combination{}
 
-	for _, res := range ranks {
-		if len(res) == 3 { //nolint:mnd
-			three = append(three, res)
+	for _, res := range suits {
+		if len(res) < 5 { //nolint:mnd
+			continue
 		}
 
-		if len(res) == 2 { //nolint:mnd
-			two = append(two, res)
+		res = findStraight(res)
+		if res == nil {
+			continue
 		}
-	}
 
-	var mx int
-	var fThree []int
-	var fTwo []int
-
-	for _, t := range three {
-		if cardsWeight(t) > mx {
-			mx = cardsWeight(t)
-			fThree = t
+		if combination.Cards != nil && straightTop(res)&rank <= straightTop(combination.Cards)&rank {
+			continue
 		}
-	}
 
-	for _, t := range two {
-		if cardsWeight(t) > mx {
-			mx = cardsWeight(t)
-			fTwo = t
+		if straightTop(res)&cardA != 0 {
+			combination.Combination = royalFlush
+		} else {
+			combination.Combination = straightFlush
 		}
+
+		combination.Weight = cardsWeight(res)
+		combination.KickersWeight = 0
+		combination.Kickers = []int{}
+		combination.Cards = res
 	}
 
+	return combination
+}
+
+func (b BinaryEvaluation) fourOfAKind(cards []int) *Combination {
+	ranks, order := groupByRank(cards)
+	cardSet := set.NewGenericDataSet[int](cards...)
 	combination := &Combination{}
 
-	if len(three) > 1 {
-		three = [][]int{
-			fThree,
-		}
-	}
+	for _, r := range order {
+		if len(ranks[r]) >= 4 { //nolint:mnd
+			res := ranks[r][:4]
+			combination.Combination = fourOfAKind
+			combination.Weight = cardsWeight(res)
+			combination.KickersWeight = 0
+			combination.Kickers = []int{}
+			combination.Cards = res
 
-	if len(two) > 1 {
-		two = [][]int{
-			fTwo,
+			break
 		}
 	}
 
-	if len(three)+len(two) == 2 { //nolint:mnd
-		var weight int
-		combination.Combination = fullHouse
+	combination.CalculateKicker(cardSet)
 
-		for _, res := range append(three, two...) {
-			weight |= cardsWeight(res)
-			combination.Weight += weight
-			combination.KickersWeight = 0
-			combination.Kickers = []int{}
-			combination.Cards = append(combination.Cards, res...)
+	return combination
+}
+
+func (b BinaryEvaluation) fullHouse(cards []int) *Combination {
+	ranks, order := groupByRank(cards)
+	combination := &Combination{}
+
+	var three, two []int
+
+	for _, r := range order {
+		switch {
+		case len(ranks[r]) >= 3 && three == nil: //nolint:mnd
+			three = ranks[r][:3]
+		case len(ranks[r]) >= 2 && two == nil: //nolint:mnd
+			two = ranks[r][:2]
 		}
 	}
 
-	combination.CalculateKicker(cardSet)
+	if three != nil && two != nil {
+		combination.Combination = fullHouse
+		combination.Cards = append(append([]int{}, three...), two...)
+		combination.Weight = cardsWeight(combination.Cards)
+		combination.KickersWeight = 0
+		combination.Kickers = []int{}
+	}
 
 	return combination
 }
@@ -324,19 +383,21 @@ func (b BinaryEvaluation) flush(cards []int) *Combination {
 	combination := &Combination{}
 
 	for _, res := range suits {
-		if len(res) > 5 { //nolint:mnd
-			res = res[len(res)-5:]
+		if len(res) < 5 { //nolint:mnd
+			continue
 		}
 
-		if len(res) == 5 { //nolint:mnd
-			weight := cardsWeight(res)
-			if weight > combination.Weight {
-				combination.Combination = flush
-				combination.Weight = weight
-				combination.KickersWeight = 0
-				combination.Kickers = []int{}
-				combination.Cards = res
-			}
+		res = res[len(res)-5:]
+
+		candidate := &Combination{
+			Combination: flush,
+			Weight:      cardsWeight(res),
+			Kickers:     []int{},
+			Cards:       res,
+		}
+
+		if combination.Combination == 0 || candidate.Score() > combination.Score() {
+			combination = candidate
 		}
 	}
 
@@ -344,79 +405,35 @@ func (b BinaryEvaluation) flush(cards []int) *Combination {
 }
 
 func (b BinaryEvaluation) straight(cards []int) *Combination {
-	ranks := map[int][]int{}
-
-	var lastRank int
-	id := 0
-
-	for _, c := range cards {
-		if lastRank == c&rank {
-			continue
-		}
-
-		if lastRank != 0 && lastRank<<1 != c&rank {
-			id++
-		}
-
-		ranks[id] = append(ranks[id], c)
-		lastRank = c & rank
-	}
-
-	ace := cards[len(cards)-1]&cardA != 0
 	combination := &Combination{}
 
-	for _, res := range ranks {
-		if len(res) > 5 { //nolint:mnd
-			res = res[len(res)-5:] //nolint:mnd
-		}
-
-		if ace && len(res) == 4 && res[0]&card2 != 0 { //nolint:mnd
-			res = append(res, cards[len(cards)-1])
-
-			weight := cardsWeight(res)
-			if weight > combination.Weight {
-				combination.Combination = straight
-				combination.Weight = weight
-				combination.KickersWeight = 0
-				combination.Kickers = []int{}
-				combination.Cards = res
-			}
-		} else if len(res) == 5 { //nolint:mnd
-			weight := cardsWeight(res)
-			if weight > combination.Weight {
-				combination.Combination = straight
-				combination.Weight = weight
-				combination.KickersWeight = 0
-				combination.Kickers = []int{}
-				combination.Cards = res
-			}
-		}
+	res := findStraight(cards)
+	if res != nil {
+		combination.Combination = straight
+		combination.Weight = cardsWeight(res)
+		combination.KickersWeight = 0
+		combination.Kickers = []int{}
+		combination.Cards = res
 	}
 
 	return combination
 }
 
 func (b BinaryEvaluation) threeOfAKind(cards []int) *Combination {
-	ranks := map[int][]int{}
+	ranks, order := groupByRank(cards)
+	cardSet := set.NewGenericDataSet[int](cards...)
 	combination := &Combination{}
-	cardSet := set.NewGenericDataSet[int]()
 
-	for _, c := range cards {
-		r := c & rank
-		ranks[r] = append(ranks[r], c)
-		cardSet.Add(c)
-	}
-
-	for _, res := range ranks { //nolint:mnd
-		if len(res) == 3 { //nolint:mnd
-			weight := cardsWeight(res)
-			if weight > combination.Weight {
-				combination.Combination = threeOfAKind
-				combination.Weight = weight
-				combination.KickersWeight = 0
-				combination.Kickers = []int{}
-				combination.Cards = res
-			}
+	for _, r := range order {
+		if len(ranks[r]) >= 3 { //nolint:mnd
+			res := ranks[r][:3]
+			combination.Combination = threeOfAKind
+			combination.Weight = cardsWeight(res)
+			combination.KickersWeight = 0
+			combination.Kickers = []int{}
+			combination.Cards = res
+
+			break
 		}
 	}
 
@@ -426,63 +443,24 @@ func (b BinaryEvaluation) threeOfAKind(cards []int) *Combination {
 }
 
 func (b BinaryEvaluation) twoPair(cards []int) *Combination {
-	ranks := map[int][]int{}
-	cardSet := set.NewGenericDataSet[int]()
-	var data [][]int
-
-	for _, c := range cards {
-		r := c & rank
-		ranks[r] = append(ranks[r], c)
-		cardSet.Add(c)
-	}
-
-	for _, res := range ranks {
-		if len(res) == 2 { //nolint:mnd
-			data = append(data, res)
-		}
-	}
-
-	var mx int
-	var index int
-	var fData []int
-	var fData2 []int
-
-	for i, t := range data {
-		if cardsWeight(t) > mx {
-			mx = cardsWeight(t)
-			fData = t
-			index = i
-		}
-	}
-
-	mx = 0
-	for i, t := range data {
-		if i != index && cardsWeight(t) > mx {
-			mx = cardsWeight(t)
-			fData2 = t
-		}
-	}
-
+	ranks, order := groupByRank(cards)
+	cardSet := set.NewGenericDataSet[int](cards...)
 	combination := &Combination{}
 
-	if len(data) > 2 { //nolint:mnd
-		data = [][]int{
-			fData,
-			fData2,
+	var pairs [][]int
+
+	for _, r := range order {
+		if len(ranks[r]) >= 2 && len(pairs) < 2 { //nolint:mnd
+			pairs = append(pairs, ranks[r][:2])
 		}
 	}
 
-	if len(data) == 2 { //nolint:mnd
-		var weight int
+	if len(pairs) == 2 { //nolint:mnd
 		combination.Combination = twoPair
-
-		for _, res := range data {
-			weight |= cardsWeight(res)
-			combination.Weight += weight
-			combination.KickersWeight = 0
-			combination.Kickers = []int{}
-			combination.Cards = append(combination.Cards, res...)
-		}
+		combination.Cards = append(append([]int{}, pairs[0]...), pairs[1]...)
+		combination.Weight = cardsWeight(combination.Cards)
+		combination.KickersWeight = 0
+		combination.Kickers = []int{}
 	}
 
 	combination.CalculateKicker(cardSet)
@@ -491,26 +469,20 @@ func (b BinaryEvaluation) twoPair(cards []int) *Combination {
 }
 
 func (b BinaryEvaluation) onePair(cards []int) *Combination {
-	ranks := map[int][]int{}
-	cardSet := set.NewGenericDataSet[int]()
+	ranks, order := groupByRank(cards)
+	cardSet := set.NewGenericDataSet[int](cards...)
 	combination := &Combination{}
 
-	for _, c := range cards {
-		r := c & rank
-		ranks[r] = append(ranks[r], c)
-		cardSet.Add(c)
-	}
-
-	for _, res := range ranks {
-		if len(res) == 2 { //nolint:mnd
-			weight := cardsWeight(res)
-			if weight > combination.Weight {
-				combination.Combination = onePair
-				combination.Weight = weight
-				combination.KickersWeight = 0
-				combination.Kickers = []int{}
-				combination.Cards = res
-			}
+	for _, r := range order {
+		if len(ranks[r]) >= 2 { //nolint:mnd
+			res := ranks[r][:2]
+			combination.Combination = onePair
+			combination.Weight = cardsWeight(res)
+			combination.KickersWeight = 0
+			combination.Kickers = []int{}
+			combination.Cards = res
+
+			break
 		}
 	}
 
@@ -520,28 +492,16 @@ func (b BinaryEvaluation) onePair(cards []int) *Combination {
 }
 
 func (b BinaryEvaluation) highCard(cards []int) *Combination {
-	ranks := map[int][]int{}
-	cardSet := set.NewGenericDataSet[int]()
-
-	for _, c := range cards {
-		r := c & rank
-		ranks[r] = append(ranks[r], c)
-		cardSet.Add(c)
-	}
-
+	cardSet := set.NewGenericDataSet[int](cards...)
 	combination := &Combination{}
 
-	for _, res := range ranks {
-		if len(res) == 1 {
-			weight := cardsWeight(res)
-			if weight > combination.Weight {
-				combination.Combination = highCard
-				combination.Weight = weight
-				combination.KickersWeight = 0
-				combination.Kickers = []int{}
-				combination.Cards = res
-			}
-		}
+	if len(cards) != 0 {
+		res := []int{cards[len(cards)-1]}
+		combination.Combination = highCard
+		combination.Weight = cardsWeight(res)
+		combination.KickersWeight = 0
+		combination.Kickers = []int{}
+		combination.Cards = res
 	}
 
 	combination.CalculateKicker(cardSet)
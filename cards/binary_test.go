@@ -90,6 +90,51 @@ func TestCornerCases(t *testing.T) {
 			combination1: "flush",
 			combination2: "highCard",
 		},
+		{
+			name:         "two trips fullHouse vs flush",
+			cards1:       []string{"Ah", "Ac", "5h", "As", "5d", "5s", "8d"},
+			cards2:       []string{"4s", "3s", "5h", "As", "5d", "5s", "8s"},
+			win1:         true,
+			split:        false,
+			combination1: "fullHouse",
+			combination2: "flush",
+		},
+		{
+			name:         "suited gap is not straightFlush",
+			cards1:       []string{"5h", "6c", "7h", "8h", "9h", "Th", "2c"},
+			cards2:       []string{"5h", "6h", "7h", "8h", "9h", "Th", "2c"},
+			win1:         false,
+			split:        false,
+			combination1: "flush",
+			combination2: "straightFlush",
+		},
+		{
+			name:         "pair kicker",
+			cards1:       []string{"Kh", "Kc", "2d", "7s", "9d", "Jc", "3s"},
+			cards2:       []string{"2h", "2c", "Ad", "7s", "9d", "Jc", "3s"},
+			win1:         true,
+			split:        false,
+			combination1: "onePair",
+			combination2: "onePair",
+		},
+		{
+			name:         "wheel vs six high straight",
+			cards1:       []string{"Ah", "2c", "3d", "4s", "5d", "Jc", "Js"},
+			cards2:       []string{"6h", "2c", "3d", "4s", "5d", "Jc", "Js"},
+			win1:         false,
+			split:        false,
+			combination1: "straight",
+			combination2: "straight",
+		},
+		{
+			name:         "third pair plays as kicker",
+			cards1:       []string{"Kh", "Kc", "Qd", "Qs", "Jd", "Jc", "3s"},
+			cards2:       []string{"Kh", "Kc", "Qd", "Qs", "2d", "2c", "3s"},
+			win1:         true,
+			split:        false,
+			combination1: "twoPair",
+			combination2: "twoPair",
+		},
 	}
 
 	ev := BinaryEvaluation{}
@@ -0,0 +1,218 @@
+package blackjack
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/InsideGallery/game-core/cards"
+
+	"github.com/InsideGallery/core/testutils"
+)
+
+func ids(names ...string) []int {
+	return cards.GetCardsIDs(names)
+}
+
+// newStackedTable return table which shoe deal given cards first
+func newStackedTable(t *testing.T, rules Rules, names ...string) *Table {
+	table, err := NewTable(rules, rand.NewPCG(1, 2))
+	testutils.Equal(t, err, nil)
+
+	table.shoe.deck = cards.NewDeckFromCards(ids(names...))
+
+	return table
+}
+
+func TestTotals(t *testing.T) {
+	total, soft := Total(ids("Ah", "6c"))
+	testutils.Equal(t, [2]any{total, soft}, [2]any{17, true})
+
+	total, soft = Total(ids("Ah", "6c", "Td"))
+	testutils.Equal(t, [2]any{total, soft}, [2]any{17, false})
+
+	total, soft = Total(ids("Ah", "Ac", "9d"))
+	testutils.Equal(t, [2]any{total, soft}, [2]any{21, true})
+
+	testutils.Equal(t, IsBust(ids("Kh", "Qd", "5c")), true)
+	testutils.Equal(t, IsBlackjack(ids("Ah", "Qd")), true)
+	testutils.Equal(t, IsBlackjack(ids("Ah", "5d", "5c")), false)
+
+	testutils.Equal(t, DealerHits(ids("Ah", "6c"), true), true)
+	testutils.Equal(t, DealerHits(ids("Ah", "6c"), false), false)
+	testutils.Equal(t, DealerHits(ids("Th", "6c"), false), true)
+}
+
+func TestShoe(t *testing.T) {
+	s, err := NewShoe(6, 0.75, rand.NewPCG(3, 4))
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, s.Size(), 312)
+
+	for range 233 {
+		_, err = s.Draw()
+		testutils.Equal(t, err, nil)
+	}
+
+	testutils.Equal(t, s.NeedsShuffle(), false)
+	_, err = s.Draw()
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, s.NeedsShuffle(), true)
+	testutils.Equal(t, s.Remaining(), 312-234)
+
+	s.Shuffle()
+	testutils.Equal(t, s.Remaining(), 312)
+
+	// only discarded cards come back when shoe runs out
+	s, err = NewShoe(1, 1, rand.NewPCG(3, 4))
+	testutils.Equal(t, err, nil)
+
+	for range 52 {
+		_, err = s.Draw()
+		testutils.Equal(t, err, nil)
+	}
+
+	s.Discard(ids("Ah")...)
+	c, err := s.Draw()
+	testutils.Equal(t, [2]any{c, err}, [2]any{ids("Ah")[0], nil})
+	_, err = s.Draw()
+	testutils.Equal(t, err, cards.ErrNoCardsInDeck)
+
+	_, err = NewShoe(0, 0.75, nil)
+	testutils.Equal(t, err, ErrInvalidRules)
+}
+
+func TestRound(t *testing.T) {
+	table := newStackedTable(t, DefaultRules(), "Th", "9d", "6c", "7s", "5h", "8c")
+	testutils.Equal(t, table.StartRound([]Bet{{PlayerID: 1, Amount: 10}}), nil)
+	testutils.Equal(t, table.StartRound([]Bet{{PlayerID: 1, Amount: 10}}), ErrRoundInProgress)
+	testutils.Equal(t, table.DealerCards(), ids("9d"))
+
+	d, err := table.Advise(1)
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, d, Surrender)
+	testutils.Equal(t, table.Hit(2), ErrUnknownPlayer)
+	testutils.Equal(t, table.Hit(1), nil)
+
+	testutils.Equal(t, table.Phase(), PhaseFinished)
+	testutils.Equal(t, table.DealerCards(), ids("9d", "7s", "8c"))
+
+	h := table.Boxes()[0].Hands[0]
+	testutils.Equal(t, h.Outcome, Win)
+	testutils.Equal(t, h.Payout, 20)
+}
+
+func TestBlackjackPayout(t *testing.T) {
+	table := newStackedTable(t, DefaultRules(), "Ah", "9c", "Kd", "7d")
+	testutils.Equal(t, table.StartRound([]Bet{{PlayerID: 1, Amount: 10}}), nil)
+	testutils.Equal(t, table.Phase(), PhaseFinished)
+	testutils.Equal(t, table.Boxes()[0].Hands[0].Payout, 25)
+	testutils.Equal(t, len(table.DealerCards()), 2)
+
+	rules := DefaultRules()
+	rules.Blackjack = Payout6to5
+	table = newStackedTable(t, rules, "Ah", "9c", "Kd", "7d")
+	testutils.Equal(t, table.StartRound([]Bet{{PlayerID: 1, Amount: 10}}), nil)
+	testutils.Equal(t, table.Boxes()[0].Hands[0].Payout, 22)
+}
+
+func TestInsurance(t *testing.T) {
+	table := newStackedTable(t, DefaultRules(), "Th", "9c", "Ah", "9d", "Kd", "Ks")
+	testutils.Equal(t, table.StartRound([]Bet{{PlayerID: 1, Amount: 10}, {PlayerID: 2, Amount: 10}}), nil)
+	testutils.Equal(t, table.Phase(), PhaseInsurance)
+	testutils.Equal(t, table.Hit(1), ErrNoRound)
+	testutils.Equal(t, table.Insure(1, true), nil)
+	testutils.Equal(t, table.Insure(1, true), ErrInvalidAction)
+	testutils.Equal(t, table.Insure(2, false), nil)
+
+	testutils.Equal(t, table.Phase(), PhaseFinished)
+
+	boxes := table.Boxes()
+	testutils.Equal(t, boxes[0].Hands[0].Outcome, Lose)
+	testutils.Equal(t, boxes[0].InsurancePayout, 15)
+	testutils.Equal(t, boxes[1].InsurancePayout, 0)
+}
+
+func TestSplitAndDouble(t *testing.T) {
+	table := newStackedTable(t, DefaultRules(), "8h", "6c", "8d", "Td", "3c", "Tc", "Kc", "9s")
+	testutils.Equal(t, table.StartRound([]Bet{{PlayerID: 1, Amount: 20}}), nil)
+
+	allowed, err := table.Allowed(1)
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, allowed, Allowed{Double: true, Split: true, Surrender: true})
+
+	d, err := table.Advise(1)
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, d, Split)
+	testutils.Equal(t, table.Split(1), nil)
+
+	allowed, err = table.Allowed(1)
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, allowed, Allowed{Double: true, Split: false, Surrender: false})
+	testutils.Equal(t, table.Surrender(1), ErrInvalidAction)
+	testutils.Equal(t, table.Double(1), nil)
+
+	_, hand, _ := table.ToAct()
+	testutils.Equal(t, hand, 1)
+	testutils.Equal(t, table.Stand(1), nil)
+
+	hands := table.Boxes()[0].Hands
+	testutils.Equal(t, hands[0].Cards, ids("8h", "3c", "Kc"))
+	testutils.Equal(t, hands[0].Payout, 80)
+	testutils.Equal(t, hands[1].Cards, ids("8d", "Tc"))
+	testutils.Equal(t, hands[1].Payout, 40)
+}
+
+func TestSurrender(t *testing.T) {
+	table := newStackedTable(t, DefaultRules(), "Th", "Ts", "6c", "7d")
+	testutils.Equal(t, table.StartRound([]Bet{{PlayerID: 1, Amount: 10}}), nil)
+	testutils.Equal(t, table.Surrender(1), nil)
+
+	h := table.Boxes()[0].Hands[0]
+	testutils.Equal(t, h.Outcome, Surrendered)
+	testutils.Equal(t, h.Payout, 5)
+	testutils.Equal(t, len(table.DealerCards()), 2)
+}
+
+func TestTurns(t *testing.T) {
+	table := newStackedTable(t, DefaultRules(), "Th", "Tc", "9s", "7h", "7c", "8d", "Ts", "Td")
+	testutils.Equal(t, table.StartRound([]Bet{{PlayerID: 1, Amount: 10}, {PlayerID: 2, Amount: 10}}), nil)
+	testutils.Equal(t, table.Stand(2), ErrNotYourTurn)
+	testutils.Equal(t, table.Stand(1), nil)
+	testutils.Equal(t, table.Hit(2), nil)
+
+	// dealer 17 stands
+	boxes := table.Boxes()
+	testutils.Equal(t, boxes[0].Hands[0].Outcome, Push)
+	testutils.Equal(t, boxes[1].Hands[0].Outcome, Bust)
+	testutils.Equal(t, table.StartRound(nil), ErrNoBets)
+	testutils.Equal(t, table.StartRound([]Bet{{PlayerID: 1}}), ErrInvalidBet)
+	testutils.Equal(t, table.StartRound([]Bet{{PlayerID: 1, Amount: 10}, {PlayerID: 1, Amount: 10}}), ErrDuplicatePlayer)
+}
+
+func TestAdvise(t *testing.T) {
+	all := Allowed{Double: true, Split: true, Surrender: true}
+	testcases := []struct {
+		hand      []string
+		up        string
+		hitSoft17 bool
+		allowed   Allowed
+		expected  Decision
+	}{
+		{hand: []string{"Th", "6c"}, up: "Kd", allowed: all, expected: Surrender},
+		{hand: []string{"Th", "6c"}, up: "Kd", expected: Hit},
+		{hand: []string{"8h", "8c"}, up: "Kd", allowed: all, expected: Split},
+		{hand: []string{"Ah", "7c"}, up: "3d", allowed: all, expected: Double},
+		{hand: []string{"Ah", "7c"}, up: "3d", expected: Stand},
+		{hand: []string{"Ah", "7c"}, up: "9d", allowed: all, expected: Hit},
+		{hand: []string{"6h", "5c"}, up: "Ad", allowed: all, expected: Hit},
+		{hand: []string{"6h", "5c"}, up: "Ad", hitSoft17: true, allowed: all, expected: Double},
+		{hand: []string{"7h", "5c"}, up: "4d", allowed: all, expected: Stand},
+		{hand: []string{"Th", "Tc"}, up: "6d", allowed: all, expected: Stand},
+		{hand: []string{"9h", "9c"}, up: "7d", allowed: all, expected: Stand},
+		{hand: []string{"5h", "5c"}, up: "6d", allowed: all, expected: Double},
+	}
+
+	for _, tc := range testcases {
+		d := Advise(ids(tc.hand...), cards.GetCardID(tc.up), tc.hitSoft17, tc.allowed)
+		testutils.Equal(t, d, tc.expected)
+	}
+}
@@ -0,0 +1,16 @@
+package blackjack
+
+import "errors"
+
+// All kind of errors for blackjack
+var (
+	ErrInvalidRules    = errors.New("invalid rules")
+	ErrNoBets          = errors.New("no bets")
+	ErrInvalidBet      = errors.New("invalid bet")
+	ErrRoundInProgress = errors.New("round in progress")
+	ErrNoRound         = errors.New("round not started")
+	ErrNotYourTurn     = errors.New("not your turn")
+	ErrInvalidAction   = errors.New("action not allowed")
+	ErrUnknownPlayer   = errors.New("unknown player")
+	ErrDuplicatePlayer = errors.New("player has several bets")
+)
@@ -0,0 +1,66 @@
+package blackjack
+
+import (
+	"github.com/InsideGallery/game-core/cards"
+)
+
+const (
+	blackjack    = 21
+	softAceBonus = 10
+	faceValue    = 10
+)
+
+// CardValue return blackjack value of card, ace counted as 1
+func CardValue(c int) int {
+	card, err := cards.CardFromInt(c)
+	if err != nil {
+		return 0
+	}
+
+	switch {
+	case card.Rank == cards.Ace:
+		return 1
+	case card.Rank >= cards.Ten:
+		return faceValue
+	}
+
+	return int(card.Rank)
+}
+
+// Total return best total of cards and true if ace counted as 11
+func Total(hand []int) (int, bool) {
+	total, ace := 0, false
+
+	for _, c := range hand {
+		v := CardValue(c)
+		total += v
+		ace = ace || v == 1
+	}
+
+	if ace && total+softAceBonus <= blackjack {
+		return total + softAceBonus, true
+	}
+
+	return total, false
+}
+
+// IsBlackjack return true for ace and ten value card
+func IsBlackjack(hand []int) bool {
+	total, _ := Total(hand)
+
+	return len(hand) == 2 && total == blackjack //nolint:mnd
+}
+
+// IsBust return true if total is over 21
+func IsBust(hand []int) bool {
+	total, _ := Total(hand)
+
+	return total > blackjack
+}
+
+// DealerHits return true if dealer must draw with given cards
+func DealerHits(hand []int, hitSoft17 bool) bool {
+	total, soft := Total(hand)
+
+	return total < 17 || (total == 17 && soft && hitSoft17) //nolint:mnd
+}
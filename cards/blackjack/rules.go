@@ -0,0 +1,59 @@
+package blackjack
+
+// Payout describe blackjack payout ratio
+type Payout struct {
+	Num int
+	Den int
+}
+
+// Blackjack payouts
+var (
+	Payout3to2 = Payout{Num: 3, Den: 2}
+	Payout6to5 = Payout{Num: 6, Den: 5}
+)
+
+// Win return winnings for bet without stake
+func (p Payout) Win(bet int) int {
+	return bet * p.Num / p.Den
+}
+
+// Rules describe table rules
+type Rules struct {
+	Decks            int
+	Penetration      float64 // part of shoe dealt before cut card
+	HitSoft17        bool    // H17 if true, S17 otherwise
+	Blackjack        Payout
+	DoubleAfterSplit bool
+	ResplitAces      bool
+	MaxHands         int // maximum hands of single box after splits
+	Surrender        bool
+	Insurance        bool
+	MinBet           int
+	MaxBet           int // unlimited if zero
+}
+
+// DefaultRules six decks, S17, 3:2, double after split, late surrender, insurance
+func DefaultRules() Rules {
+	return Rules{
+		Decks:            6,    //nolint:mnd
+		Penetration:      0.75, //nolint:mnd
+		Blackjack:        Payout3to2,
+		DoubleAfterSplit: true,
+		MaxHands:         4, //nolint:mnd
+		Surrender:        true,
+		Insurance:        true,
+		MinBet:           1,
+	}
+}
+
+func (r Rules) validate() error {
+	if r.Decks <= 0 || r.Penetration <= 0 || r.Penetration > 1 || r.Blackjack.Den <= 0 || r.MaxHands < 1 || r.MinBet < 0 {
+		return ErrInvalidRules
+	}
+
+	if r.MaxBet != 0 && r.MaxBet < r.MinBet {
+		return ErrInvalidRules
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package blackjack
+
+import (
+	"math/rand/v2"
+
+	"github.com/InsideGallery/game-core/cards"
+)
+
+// Shoe multi deck shoe with cut card, reshuffled when cut card reached
+type Shoe struct {
+	deck  *cards.Deck
+	size  int
+	cut   int // count of cards dealt before cut card
+	dealt int
+}
+
+// NewShoe return shuffled shoe, penetration is part of shoe dealt before cut card (0.75 for 75%)
+// Cryptographically secure source used if src is nil
+func NewShoe(decks int, penetration float64, src rand.Source) (*Shoe, error) {
+	if decks <= 0 || penetration <= 0 || penetration > 1 {
+		return nil, ErrInvalidRules
+	}
+
+	d, err := cards.ShoeBuilder(decks).BuildDeck()
+	if err != nil {
+		return nil, err
+	}
+
+	if src == nil {
+		src = cards.CryptoSource{}
+	}
+
+	d.SetSource(src)
+
+	s := &Shoe{
+		deck: d,
+		size: d.Len(),
+	}
+	s.cut = int(float64(s.size) * penetration)
+	s.Shuffle()
+
+	return s, nil
+}
+
+// Shuffle collect all cards and shuffle shoe
+func (s *Shoe) Shuffle() {
+	s.deck.Reset()
+	s.deck.Shuffle()
+	s.dealt = 0
+}
+
+// Draw return top card, discarded cards reshuffled into shoe if it runs out of cards,
+// cards still on the table are never dealt again
+func (s *Shoe) Draw() (int, error) {
+	c, err := s.deck.Top()
+	if err != nil {
+		return 0, err
+	}
+
+	s.dealt++
+
+	return c, nil
+}
+
+// Discard put cards played in finished round to discard pile
+func (s *Shoe) Discard(cards ...int) {
+	s.deck.Discard(cards...)
+}
+
+// NeedsShuffle return true if cut card reached
+func (s *Shoe) NeedsShuffle() bool {
+	return s.dealt >= s.cut
+}
+
+// Remaining return count of cards left in shoe
+func (s *Shoe) Remaining() int {
+	return s.deck.Len()
+}
+
+// Size return count of cards in full shoe
+func (s *Shoe) Size() int {
+	return s.size
+}
@@ -0,0 +1,168 @@
+package blackjack
+
+// Decision describe player decision
+type Decision uint8
+
+// Decisions
+const (
+	Hit Decision = iota + 1
+	Stand
+	Double
+	Split
+	Surrender
+)
+
+// Allowed describe optional actions available for hand
+type Allowed struct {
+	Double    bool
+	Split     bool
+	Surrender bool
+}
+
+// Advise return basic strategy decision for multi deck game with double after split
+// Hit used instead of not allowed double, stand used instead of not allowed double on soft 18 and 19
+func Advise(hand []int, upCard int, hitSoft17 bool, allowed Allowed) Decision {
+	up := CardValue(upCard)
+	if up == 1 {
+		up = 11 // ace counted as eleven to compare with other up cards
+	}
+
+	total, soft := Total(hand)
+
+	if allowed.Surrender && len(hand) == 2 && !soft { //nolint:mnd
+		if surrender(total, up, hitSoft17) && !(allowed.Split && CardValue(hand[0]) == 8) { //nolint:mnd
+			return Surrender
+		}
+	}
+
+	if allowed.Split && len(hand) == 2 && CardValue(hand[0]) == CardValue(hand[1]) { //nolint:mnd
+		if split(CardValue(hand[0]), up) {
+			return Split
+		}
+	}
+
+	if soft {
+		return softDecision(total, up, hitSoft17, allowed.Double)
+	}
+
+	return hardDecision(total, up, hitSoft17, allowed.Double)
+}
+
+func surrender(total, up int, hitSoft17 bool) bool {
+	switch total {
+	case 16: //nolint:mnd
+		return up >= 9 //nolint:mnd
+	case 15: //nolint:mnd
+		return up == 10 || (hitSoft17 && up == 11) //nolint:mnd
+	case 17: //nolint:mnd
+		return hitSoft17 && up == 11 //nolint:mnd
+	}
+
+	return false
+}
+
+// split return true if pair of given card value should be split
+func split(value, up int) bool {
+	switch value {
+	case 1, 8: //nolint:mnd
+		return true
+	case 9: //nolint:mnd
+		return up <= 9 && up != 7 //nolint:mnd
+	case 7: //nolint:mnd
+		return up <= 7 //nolint:mnd
+	case 6: //nolint:mnd
+		return up <= 6 //nolint:mnd
+	case 4: //nolint:mnd
+		return up == 5 || up == 6 //nolint:mnd
+	case 2, 3: //nolint:mnd
+		return up <= 7 //nolint:mnd
+	}
+
+	return false
+}
+
+func softDecision(total, up int, hitSoft17, canDouble bool) Decision {
+	double := func(stand bool) Decision {
+		switch {
+		case canDouble:
+			return Double
+		case stand:
+			return Stand
+		}
+
+		return Hit
+	}
+
+	switch {
+	case total >= 20: //nolint:mnd
+		return Stand
+	case total == 19: //nolint:mnd
+		if hitSoft17 && up == 6 { //nolint:mnd
+			return double(true)
+		}
+
+		return Stand
+	case total == 18: //nolint:mnd
+		switch {
+		case up >= 3 && up <= 6, hitSoft17 && up == 2: //nolint:mnd
+			return double(true)
+		case up <= 8: //nolint:mnd
+			return Stand
+		}
+
+		return Hit
+	case total == 17: //nolint:mnd
+		if up >= 3 && up <= 6 { //nolint:mnd
+			return double(false)
+		}
+	case total >= 15: //nolint:mnd
+		if up >= 4 && up <= 6 { //nolint:mnd
+			return double(false)
+		}
+	case total == 12: //nolint:mnd
+		return Hit
+	default:
+		if up >= 5 && up <= 6 { //nolint:mnd
+			return double(false)
+		}
+	}
+
+	return Hit
+}
+
+func hardDecision(total, up int, hitSoft17, canDouble bool) Decision {
+	double := func() Decision {
+		if canDouble {
+			return Double
+		}
+
+		return Hit
+	}
+
+	switch {
+	case total >= 17: //nolint:mnd
+		return Stand
+	case total >= 13: //nolint:mnd
+		if up <= 6 { //nolint:mnd
+			return Stand
+		}
+	case total == 12: //nolint:mnd
+		if up >= 4 && up <= 6 { //nolint:mnd
+			return Stand
+		}
+	case total == 11: //nolint:mnd
+		if up <= 10 || hitSoft17 { //nolint:mnd
+			return double()
+		}
+	case total == 10: //nolint:mnd
+		if up <= 9 { //nolint:mnd
+			return double()
+		}
+	case total == 9: //nolint:mnd
+		if up >= 3 && up <= 6 { //nolint:mnd
+			return double()
+		}
+	}
+
+	return Hit
+}
@@ -0,0 +1,582 @@
+package blackjack
+
+import (
+	"math/rand/v2"
+	"slices"
+	"sync"
+
+	"github.com/InsideGallery/game-core/cards"
+)
+
+// Outcome describe result of hand
+type Outcome uint8
+
+// Hand outcomes
+const (
+	Pending Outcome = iota
+	Win
+	Lose
+	Push
+	BlackjackWin
+	Surrendered
+	Bust
+)
+
+// Phase describe state of round
+type Phase uint8
+
+// Round phases
+const (
+	PhaseIdle Phase = iota
+	PhaseInsurance
+	PhasePlayers
+	PhaseFinished
+)
+
+// Bet describe initial bet of player
+type Bet struct {
+	PlayerID uint64
+	Amount   int
+}
+
+// Hand describe single player hand, box has several hands after split
+type Hand struct {
+	Cards     []int
+	Bet       int
+	Doubled   bool
+	Split     bool // hand made by split
+	Done      bool
+	Outcome   Outcome
+	Payout    int // chips returned to player, stake included
+	splitAces bool
+	surrender bool
+}
+
+// Box describe player position with hands and insurance
+type Box struct {
+	PlayerID         uint64
+	Hands            []*Hand
+	Insurance        int
+	InsurancePayout  int
+	insuranceDecided bool
+}
+
+// Table blackjack table with shoe and dealer
+type Table struct {
+	rules  Rules
+	shoe   *Shoe
+	dealer []int
+	boxes  []*Box
+	phase  Phase
+	box    int // index of box to act
+	hand   int // index of hand to act
+
+	mu sync.Mutex
+}
+
+// NewTable return new table, cryptographically secure shoe used if src is nil
+func NewTable(rules Rules, src rand.Source) (*Table, error) {
+	if err := rules.validate(); err != nil {
+		return nil, err
+	}
+
+	shoe, err := NewShoe(rules.Decks, rules.Penetration, src)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Table{
+		rules: rules,
+		shoe:  shoe,
+	}, nil
+}
+
+// Rules return table rules
+func (t *Table) Rules() Rules {
+	return t.rules
+}
+
+// Shoe return shoe of table
+func (t *Table) Shoe() *Shoe {
+	return t.shoe
+}
+
+// Phase return phase of current round
+func (t *Table) Phase() Phase {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.phase
+}
+
+// DealerCards return dealer cards, only up card returned until players finished
+func (t *Table) DealerCards() []int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.phase != PhaseFinished && len(t.dealer) > 0 {
+		return t.dealer[:1:1]
+	}
+
+	return slices.Clone(t.dealer)
+}
+
+// UpCard return dealer up card
+func (t *Table) UpCard() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if len(t.dealer) == 0 {
+		return 0
+	}
+
+	return t.dealer[0]
+}
+
+// Boxes return copy of boxes
+func (t *Table) Boxes() []Box {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	result := make([]Box, len(t.boxes))
+
+	for i, b := range t.boxes {
+		result[i] = *b
+		result[i].Hands = make([]*Hand, len(b.Hands))
+
+		for j, h := range b.Hands {
+			c := *h
+			c.Cards = slices.Clone(h.Cards)
+			result[i].Hands[j] = &c
+		}
+	}
+
+	return result
+}
+
+// ToAct return player and index of hand to act
+func (t *Table) ToAct() (uint64, int, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.phase != PhasePlayers {
+		return 0, 0, false
+	}
+
+	return t.boxes[t.box].PlayerID, t.hand, true
+}
+
+// StartRound take bets and deal cards, shoe reshuffled if cut card reached, each player has one bet
+func (t *Table) StartRound(bets []Bet) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.phase == PhaseInsurance || t.phase == PhasePlayers {
+		return ErrRoundInProgress
+	}
+
+	if len(bets) == 0 {
+		return ErrNoBets
+	}
+
+	for i, b := range bets {
+		if b.Amount < t.rules.MinBet || b.Amount <= 0 || (t.rules.MaxBet != 0 && b.Amount > t.rules.MaxBet) {
+			return ErrInvalidBet
+		}
+
+		if slices.ContainsFunc(bets[:i], func(o Bet) bool { return o.PlayerID == b.PlayerID }) {
+			return ErrDuplicatePlayer
+		}
+	}
+
+	t.discard()
+
+	if t.shoe.NeedsShuffle() {
+		t.shoe.Shuffle()
+	}
+
+	t.dealer = nil
+	t.boxes = make([]*Box, len(bets))
+
+	for i, b := range bets {
+		t.boxes[i] = &Box{PlayerID: b.PlayerID, Hands: []*Hand{{Bet: b.Amount}}}
+	}
+
+	for range 2 {
+		for _, b := range t.boxes {
+			if err := t.draw(&b.Hands[0].Cards); err != nil {
+				return err
+			}
+		}
+
+		if err := t.draw(&t.dealer); err != nil {
+			return err
+		}
+	}
+
+	if t.rules.Insurance && CardValue(t.dealer[0]) == 1 {
+		t.phase = PhaseInsurance
+		return nil
+	}
+
+	return t.peek()
+}
+
+// Insure take or decline insurance, costs half of initial bet
+func (t *Table) Insure(playerID uint64, take bool) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.phase != PhaseInsurance {
+		return ErrInvalidAction
+	}
+
+	b := t.boxOf(playerID)
+	if b == nil {
+		return ErrUnknownPlayer
+	}
+
+	if b.insuranceDecided {
+		return ErrInvalidAction
+	}
+
+	b.insuranceDecided = true
+	if take {
+		b.Insurance = b.Hands[0].Bet / 2 //nolint:mnd
+	}
+
+	for _, b := range t.boxes {
+		if !b.insuranceDecided {
+			return nil
+		}
+	}
+
+	return t.peek()
+}
+
+// Hit draw card to current hand
+func (t *Table) Hit(playerID uint64) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	h, err := t.current(playerID)
+	if err != nil {
+		return err
+	}
+
+	if err := t.draw(&h.Cards); err != nil {
+		return err
+	}
+
+	if total, _ := Total(h.Cards); total >= blackjack {
+		h.Done = true
+	}
+
+	return t.advance()
+}
+
+// Stand finish current hand
+func (t *Table) Stand(playerID uint64) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	h, err := t.current(playerID)
+	if err != nil {
+		return err
+	}
+
+	h.Done = true
+
+	return t.advance()
+}
+
+// Double double bet and draw single card
+func (t *Table) Double(playerID uint64) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	h, err := t.current(playerID)
+	if err != nil {
+		return err
+	}
+
+	if !t.canDouble(h) {
+		return ErrInvalidAction
+	}
+
+	h.Bet *= 2
+	h.Doubled = true
+	h.Done = true
+
+	if err := t.draw(&h.Cards); err != nil {
+		return err
+	}
+
+	return t.advance()
+}
+
+// Split split pair into two hands with the same bet
+func (t *Table) Split(playerID uint64) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	h, err := t.current(playerID)
+	if err != nil {
+		return err
+	}
+
+	b := t.boxes[t.box]
+	if !t.canSplit(b, h) {
+		return ErrInvalidAction
+	}
+
+	aces := CardValue(h.Cards[0]) == 1
+	second := &Hand{Cards: []int{h.Cards[1]}, Bet: h.Bet, Split: true, splitAces: aces}
+	h.Cards = h.Cards[:1]
+	h.Split, h.splitAces = true, aces
+
+	b.Hands = slices.Insert(b.Hands, t.hand+1, second)
+
+	for _, hand := range []*Hand{h, second} {
+		if err := t.draw(&hand.Cards); err != nil {
+			return err
+		}
+
+		// split aces receive single card unless they can be split again
+		if aces && !(t.rules.ResplitAces && t.canSplit(b, hand)) {
+			hand.Done = true
+		}
+	}
+
+	return t.advance()
+}
+
+// Surrender give up first two cards and get half of bet back
+func (t *Table) Surrender(playerID uint64) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	h, err := t.current(playerID)
+	if err != nil {
+		return err
+	}
+
+	if !t.canSurrender(t.boxes[t.box], h) {
+		return ErrInvalidAction
+	}
+
+	h.surrender = true
+	h.Done = true
+
+	return t.advance()
+}
+
+// Allowed return actions available for current hand of player
+func (t *Table) Allowed(playerID uint64) (Allowed, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	h, err := t.current(playerID)
+	if err != nil {
+		return Allowed{}, err
+	}
+
+	b := t.boxes[t.box]
+
+	return Allowed{
+		Double:    t.canDouble(h),
+		Split:     t.canSplit(b, h),
+		Surrender: t.canSurrender(b, h),
+	}, nil
+}
+
+// Advise return basic strategy decision for current hand of player
+func (t *Table) Advise(playerID uint64) (Decision, error) {
+	allowed, err := t.Allowed(playerID)
+	if err != nil {
+		return 0, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return Advise(t.boxes[t.box].Hands[t.hand].Cards, t.dealer[0], t.rules.HitSoft17, allowed), nil
+}
+
+func (t *Table) canDouble(h *Hand) bool {
+	return len(h.Cards) == 2 && (!h.Split || t.rules.DoubleAfterSplit) && !h.splitAces //nolint:mnd
+}
+
+func (t *Table) canSplit(b *Box, h *Hand) bool {
+	if len(h.Cards) != 2 || len(b.Hands) >= t.rules.MaxHands { //nolint:mnd
+		return false
+	}
+
+	if h.splitAces && !t.rules.ResplitAces {
+		return false
+	}
+
+	return sameRank(h.Cards[0], h.Cards[1])
+}
+
+func (t *Table) canSurrender(b *Box, h *Hand) bool {
+	return t.rules.Surrender && len(b.Hands) == 1 && len(h.Cards) == 2 //nolint:mnd
+}
+
+// current return hand to act if it belongs to player
+func (t *Table) current(playerID uint64) (*Hand, error) {
+	if t.phase != PhasePlayers {
+		return nil, ErrNoRound
+	}
+
+	b := t.boxes[t.box]
+	if b.PlayerID != playerID {
+		if t.boxOf(playerID) == nil {
+			return nil, ErrUnknownPlayer
+		}
+
+		return nil, ErrNotYourTurn
+	}
+
+	return b.Hands[t.hand], nil
+}
+
+// peek check dealer blackjack, round finished if dealer has it
+func (t *Table) peek() error {
+	up := CardValue(t.dealer[0])
+	if (up == 1 || up == faceValue) && IsBlackjack(t.dealer) {
+		t.settle()
+		return nil
+	}
+
+	t.phase = PhasePlayers
+	t.box, t.hand = 0, -1
+
+	for _, b := range t.boxes {
+		if IsBlackjack(b.Hands[0].Cards) {
+			b.Hands[0].Done = true
+		}
+	}
+
+	return t.advance()
+}
+
+// advance move to next not finished hand, dealer plays when all hands done
+func (t *Table) advance() error {
+	if t.hand >= 0 && !t.boxes[t.box].Hands[t.hand].Done {
+		return nil
+	}
+
+	for ; t.box < len(t.boxes); t.box, t.hand = t.box+1, -1 {
+		hands := t.boxes[t.box].Hands
+
+		for t.hand++; t.hand < len(hands); t.hand++ {
+			if !hands[t.hand].Done {
+				return nil
+			}
+		}
+	}
+
+	if t.dealerPlays() {
+		for DealerHits(t.dealer, t.rules.HitSoft17) {
+			if err := t.draw(&t.dealer); err != nil {
+				return err
+			}
+		}
+	}
+
+	t.settle()
+
+	return nil
+}
+
+// dealerPlays return true if any hand waits for dealer
+func (t *Table) dealerPlays() bool {
+	for _, b := range t.boxes {
+		for _, h := range b.Hands {
+			if !h.surrender && !IsBust(h.Cards) && !(IsBlackjack(h.Cards) && !h.Split) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// settle calculate outcomes and payouts
+func (t *Table) settle() {
+	t.phase = PhaseFinished
+
+	dealerTotal, _ := Total(t.dealer)
+	dealerBlackjack := IsBlackjack(t.dealer)
+
+	for _, b := range t.boxes {
+		if dealerBlackjack && b.Insurance > 0 {
+			b.InsurancePayout = b.Insurance * 3 //nolint:mnd
+		}
+
+		for _, h := range b.Hands {
+			total, _ := Total(h.Cards)
+			natural := IsBlackjack(h.Cards) && !h.Split
+
+			switch {
+			case h.surrender:
+				h.Outcome, h.Payout = Surrendered, h.Bet/2 //nolint:mnd
+			case natural && dealerBlackjack:
+				h.Outcome, h.Payout = Push, h.Bet
+			case natural:
+				h.Outcome, h.Payout = BlackjackWin, h.Bet+t.rules.Blackjack.Win(h.Bet)
+			case dealerBlackjack:
+				h.Outcome = Lose
+			case total > blackjack:
+				h.Outcome = Bust
+			case dealerTotal > blackjack || total > dealerTotal:
+				h.Outcome, h.Payout = Win, h.Bet*2 //nolint:mnd
+			case total == dealerTotal:
+				h.Outcome, h.Payout = Push, h.Bet
+			default:
+				h.Outcome = Lose
+			}
+		}
+	}
+}
+
+func (t *Table) draw(hand *[]int) error {
+	c, err := t.shoe.Draw()
+	if err != nil {
+		return err
+	}
+
+	*hand = append(*hand, c)
+
+	return nil
+}
+
+// discard put cards of previous round to discard pile of shoe
+func (t *Table) discard() {
+	t.shoe.Discard(t.dealer...)
+
+	for _, b := range t.boxes {
+		for _, h := range b.Hands {
+			t.shoe.Discard(h.Cards...)
+		}
+	}
+}
+
+func (t *Table) boxOf(playerID uint64) *Box {
+	for _, b := range t.boxes {
+		if b.PlayerID == playerID {
+			return b
+		}
+	}
+
+	return nil
+}
+
+// sameRank return true if cards have the same rank, suits are ignored
+func sameRank(a, b int) bool {
+	ca, errA := cards.CardFromInt(a)
+	cb, errB := cards.CardFromInt(b)
+
+	return errA == nil && errB == nil && ca.Rank == cb.Rank
+}
@@ -0,0 +1,149 @@
+package cards
+
+import (
+	"slices"
+)
+
+// DeckBuilder build decks of generic cards
+type DeckBuilder struct {
+	ranks  []Rank
+	suits  []Suit
+	copies int
+	decks  int
+	jokers int
+	custom []Card
+}
+
+// NewDeckBuilder return builder of single french deck of 52 cards
+func NewDeckBuilder() *DeckBuilder {
+	return &DeckBuilder{
+		ranks:  slices.Clone(FrenchRanks),
+		suits:  slices.Clone(FrenchSuits),
+		copies: 1,
+		decks:  1,
+	}
+}
+
+// Decks set count of decks in shoe, every deck has own Copy index
+func (b *DeckBuilder) Decks(n int) *DeckBuilder {
+	b.decks = max(n, 1)
+	return b
+}
+
+// Copies set count of every card in single deck, Pinochle use two copies
+func (b *DeckBuilder) Copies(n int) *DeckBuilder {
+	b.copies = max(n, 1)
+	return b
+}
+
+// Jokers set count of jokers in single deck
+func (b *DeckBuilder) Jokers(n int) *DeckBuilder {
+	b.jokers = max(n, 0)
+	return b
+}
+
+// Ranks set ranks of deck
+func (b *DeckBuilder) Ranks(ranks ...Rank) *DeckBuilder {
+	b.ranks = slices.Clone(ranks)
+	return b
+}
+
+// Suits set suits of deck
+func (b *DeckBuilder) Suits(suits ...Suit) *DeckBuilder {
+	b.suits = slices.Clone(suits)
+	return b
+}
+
+// Strip remove ranks from deck
+func (b *DeckBuilder) Strip(ranks ...Rank) *DeckBuilder {
+	b.ranks = slices.DeleteFunc(b.ranks, func(r Rank) bool {
+		return slices.Contains(ranks, r)
+	})
+
+	return b
+}
+
+// StripBelow remove ranks lower than given rank
+func (b *DeckBuilder) StripBelow(r Rank) *DeckBuilder {
+	b.ranks = slices.DeleteFunc(b.ranks, func(v Rank) bool {
+		return v < r
+	})
+
+	return b
+}
+
+// Add add custom cards to every deck
+func (b *DeckBuilder) Add(cards ...Card) *DeckBuilder {
+	b.custom = append(b.custom, cards...)
+	return b
+}
+
+// Build return cards ordered by deck, suit and rank
+func (b *DeckBuilder) Build() []Card {
+	size := (len(b.ranks)*len(b.suits)*b.copies + b.jokers + len(b.custom)) * b.decks
+	result := make([]Card, 0, size)
+
+	for d := 0; d < b.decks; d++ {
+		for c := 0; c < b.copies; c++ {
+			for _, s := range b.suits {
+				for _, r := range b.ranks {
+					result = append(result, Card{Rank: r, Suit: s, Copy: d})
+				}
+			}
+		}
+
+		for j := 0; j < b.jokers; j++ {
+			result = append(result, Card{Rank: Joker, Copy: d})
+		}
+
+		for _, c := range b.custom {
+			c.Copy = d
+			result = append(result, c)
+		}
+	}
+
+	return result
+}
+
+// BuildInts return cards in bitmask encoding, error if deck contains jokers or custom cards
+func (b *DeckBuilder) BuildInts() ([]int, error) {
+	return CardsToInts(b.Build())
+}
+
+// BuildDeck return deck in bitmask encoding
+func (b *DeckBuilder) BuildDeck() (*Deck, error) {
+	ids, err := b.BuildInts()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDeckFromCards(ids), nil
+}
+
+// NewDeckFromCards return deck with given cards, first card is on top
+func NewDeckFromCards(cards []int) *Deck {
+	return &Deck{
+		cards:   slices.Clone(cards),
+		initial: slices.Clone(cards),
+	}
+}
+
+// ShoeBuilder return builder of shoe with n french decks
+func ShoeBuilder(n int) *DeckBuilder {
+	return NewDeckBuilder().Decks(n)
+}
+
+// PinochleBuilder return builder of 48 cards pinochle deck: two copies of nine to ace
+func PinochleBuilder() *DeckBuilder {
+	return NewDeckBuilder().StripBelow(Nine).Copies(2) //nolint:mnd
+}
+
+// EuchreBuilder return builder of 24 cards euchre deck: nine to ace
+func EuchreBuilder() *DeckBuilder {
+	return NewDeckBuilder().StripBelow(Nine)
+}
+
+// SkatBuilder return builder of 32 cards skat deck: seven to ace
+func SkatBuilder() *DeckBuilder {
+	return NewDeckBuilder().StripBelow(Seven)
+}
@@ -0,0 +1,161 @@
+package cards
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+)
+
+var ErrNotFrenchCard = errors.New("card can not be encoded as french card")
+
+// Suit of card, values greater than Spades can be used by custom cards
+type Suit uint8
+
+const (
+	SuitNone Suit = iota
+	Hearts
+	Clubs
+	Diamonds
+	Spades
+)
+
+// Rank of card, values greater than Joker can be used by custom cards
+type Rank uint8
+
+const (
+	RankNone Rank = iota
+	_
+	Two
+	Three
+	Four
+	Five
+	Six
+	Seven
+	Eight
+	Nine
+	Ten
+	Jack
+	Queen
+	King
+	Ace
+	Joker
+)
+
+var (
+	suitLetters = map[Suit]string{Hearts: "h", Clubs: "c", Diamonds: "d", Spades: "s"}
+	rankLetters = map[Rank]string{
+		Two: "2", Three: "3", Four: "4", Five: "5", Six: "6", Seven: "7", Eight: "8",
+		Nine: "9", Ten: "T", Jack: "J", Queen: "Q", King: "K", Ace: "A",
+	}
+)
+
+// FrenchSuits suits of french deck in order of bitmask encoding
+var FrenchSuits = []Suit{Hearts, Clubs, Diamonds, Spades}
+
+// FrenchRanks ranks of french deck from two to ace
+var FrenchRanks = []Rank{Two, Three, Four, Five, Six, Seven, Eight, Nine, Ten, Jack, Queen, King, Ace}
+
+// Card generic playing card
+type Card struct {
+	Rank Rank
+	Suit Suit
+	Name string // name of custom card
+	Copy int    // index of deck in multi deck shoe
+}
+
+// NewCard return french card
+func NewCard(r Rank, s Suit) Card {
+	return Card{Rank: r, Suit: s}
+}
+
+// NewJoker return joker, suit can be used to distinguish red and black jokers
+func NewJoker(s Suit) Card {
+	return Card{Rank: Joker, Suit: s}
+}
+
+// NewCustomCard return non french card with own name, rank and suit
+func NewCustomCard(name string, r Rank, s Suit) Card {
+	return Card{Rank: r, Suit: s, Name: name}
+}
+
+// IsJoker return true for jokers
+func (c Card) IsJoker() bool {
+	return c.Name == "" && c.Rank == Joker
+}
+
+// IsFrench return true if card can be encoded as bitmask
+func (c Card) IsFrench() bool {
+	return c.Name == "" && c.Rank >= Two && c.Rank <= Ace && c.Suit >= Hearts && c.Suit <= Spades
+}
+
+// Same return true if cards have the same face, copy is ignored
+func (c Card) Same(o Card) bool {
+	return c.Rank == o.Rank && c.Suit == o.Suit && c.Name == o.Name
+}
+
+// String return short name of card, "Ah" for french cards
+func (c Card) String() string {
+	switch {
+	case c.Name != "":
+		return c.Name
+	case c.IsJoker():
+		return "Joker"
+	case c.IsFrench():
+		return rankLetters[c.Rank] + suitLetters[c.Suit]
+	}
+
+	return fmt.Sprintf("%d/%d", c.Rank, c.Suit)
+}
+
+// Int return card in bitmask encoding used by evaluators
+func (c Card) Int() (int, error) {
+	if !c.IsFrench() {
+		return 0, fmt.Errorf("%w: %s", ErrNotFrenchCard, c)
+	}
+
+	return card2<<(c.Rank-Two) | suitH<<(c.Suit-Hearts), nil
+}
+
+// CardFromInt return card by bitmask encoding
+func CardFromInt(id int) (Card, error) {
+	if _, exists := cardsNames[id]; !exists {
+		return Card{}, fmt.Errorf("%w: %d", ErrInvalidCard, id)
+	}
+
+	return Card{
+		Rank: Two + Rank(rankIndex(id)),                        //nolint:gosec
+		Suit: Hearts + Suit(bits.TrailingZeros(uint(id&suit))), //nolint:gosec
+	}, nil
+}
+
+// CardsToInts return cards in bitmask encoding
+func CardsToInts(cards []Card) ([]int, error) {
+	result := make([]int, len(cards))
+
+	for i, c := range cards {
+		id, err := c.Int()
+		if err != nil {
+			return nil, err
+		}
+
+		result[i] = id
+	}
+
+	return result, nil
+}
+
+// CardsFromInts return cards by bitmask encoding
+func CardsFromInts(ids []int) ([]Card, error) {
+	result := make([]Card, len(ids))
+
+	for i, id := range ids {
+		c, err := CardFromInt(id)
+		if err != nil {
+			return nil, err
+		}
+
+		result[i] = c
+	}
+
+	return result, nil
+}
@@ -0,0 +1,75 @@
+package cards
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/InsideGallery/core/testutils"
+)
+
+func TestCardConversion(t *testing.T) {
+	for _, id := range deck {
+		c, err := CardFromInt(id)
+		testutils.Equal(t, err, nil)
+		testutils.Equal(t, c.String(), GetCardName(id))
+
+		back, err := c.Int()
+		testutils.Equal(t, err, nil)
+		testutils.Equal(t, back, id)
+	}
+
+	c, err := CardFromInt(GetCardID("Td"))
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, c, NewCard(Ten, Diamonds))
+
+	_, err = CardFromInt(0)
+	testutils.Equal(t, errors.Is(err, ErrInvalidCard), true)
+
+	_, err = NewJoker(SuitNone).Int()
+	testutils.Equal(t, errors.Is(err, ErrNotFrenchCard), true)
+
+	_, err = NewCustomCard("Skip", 1, 5).Int()
+	testutils.Equal(t, errors.Is(err, ErrNotFrenchCard), true)
+
+	ids, err := CardsToInts([]Card{NewCard(Ace, Spades), NewCard(Two, Hearts)})
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, ids, GetCardsIDs([]string{"As", "2h"}))
+
+	cs, err := CardsFromInts(ids)
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, cs, []Card{NewCard(Ace, Spades), NewCard(Two, Hearts)})
+}
+
+func TestDeckBuilder(t *testing.T) {
+	testutils.Equal(t, len(NewDeckBuilder().Build()), 52)
+	testutils.Equal(t, len(PinochleBuilder().Build()), 48)
+	testutils.Equal(t, len(EuchreBuilder().Build()), 24)
+	testutils.Equal(t, len(SkatBuilder().Build()), 32)
+
+	shoe := ShoeBuilder(6).Build()
+	testutils.Equal(t, len(shoe), 312)
+	testutils.Equal(t, shoe[0].Copy, 0)
+	testutils.Equal(t, shoe[311].Copy, 5)
+	testutils.Equal(t, shoe[0].Same(shoe[52]), true)
+
+	withJokers := NewDeckBuilder().Jokers(2).Build()
+	testutils.Equal(t, len(withJokers), 54)
+	testutils.Equal(t, withJokers[53].IsJoker(), true)
+	testutils.Equal(t, withJokers[53].String(), "Joker")
+
+	_, err := NewDeckBuilder().Jokers(1).BuildInts()
+	testutils.Equal(t, errors.Is(err, ErrNotFrenchCard), true)
+
+	stripped := NewDeckBuilder().Strip(Two, Three, Four, Five).Suits(Hearts).Build()
+	testutils.Equal(t, len(stripped), 9)
+	testutils.Equal(t, stripped[0], NewCard(Six, Hearts))
+
+	custom := NewDeckBuilder().Ranks().Suits().Add(NewCustomCard("Skip", 20, 5), NewCustomCard("Reverse", 21, 5)).Decks(2).Build()
+	testutils.Equal(t, len(custom), 4)
+	testutils.Equal(t, custom[3].String(), "Reverse")
+	testutils.Equal(t, custom[3].Copy, 1)
+
+	d, err := SkatBuilder().BuildDeck()
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, len(d.Cards()), 32)
+}
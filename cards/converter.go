@@ -74,7 +74,7 @@ func GetCardsNames(ids []int) []string {
 	return result
 }
 
-// GetCardsIDs return cards ids by names
+// GetCardsIDs return cards ids by names, unknown names are zero, use ParseCardNames to validate names
 func GetCardsIDs(names []string) []int {
 	result := make([]int, len(names))
 	for i, name := range names {
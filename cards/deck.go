@@ -1,12 +1,17 @@
 package cards
 
 import (
+	crand "crypto/rand"
+	"encoding/binary"
 	"errors"
 	"math/rand/v2"
 	"sync"
 )
 
-var ErrNoCardsInDeck = errors.New("no cards in deck")
+var (
+	ErrNoCardsInDeck   = errors.New("no cards in deck")
+	ErrInvalidPosition = errors.New("invalid position")
+)
 
 var deck = []int{
 	card2 | suitH, card2 | suitC, card2 | suitD, card2 | suitS, // 2h,2c,2d,2s
@@ -25,31 +30,98 @@ var deck = []int{
 }
 
 // Deck contains cards from current game
+// Discarded cards shuffled back under the remaining cards when deck runs dry
 type Deck struct {
-	cards []int
-	mu    sync.Mutex
+	cards     []int
+	initial   []int
+	discarded []int
+	rnd       *rand.Rand // global source used if nil
+	mu        sync.Mutex
 }
 
 // NewDeck return new deck
 func NewDeck() *Deck {
-	d := &Deck{
-		cards: make([]int, len(deck)),
-	}
-	copy(d.cards, deck)
+	return NewDeckFromCards(deck)
+}
+
+// NewDeckWithSeed return new deck which shuffle is reproducible by seed
+func NewDeckWithSeed(seed uint64) *Deck {
+	return NewDeckWithSource(rand.NewPCG(seed, seed))
+}
+
+// NewDeckWithSource return new deck which use given random source
+func NewDeckWithSource(src rand.Source) *Deck {
+	d := NewDeck()
+	d.rnd = rand.New(src) //nolint:gosec
 
 	return d
 }
 
+// SetSource set random source used by deck
+func (d *Deck) SetSource(src rand.Source) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.rnd = rand.New(src) //nolint:gosec
+}
+
+// NewSecureDeck return new deck which use cryptographically secure random source
+func NewSecureDeck() *Deck {
+	return NewDeckWithSource(CryptoSource{})
+}
+
+// CryptoSource random source based on crypto/rand
+type CryptoSource struct{}
+
+// Uint64 return cryptographically secure random number
+func (CryptoSource) Uint64() uint64 {
+	var b [8]byte
+
+	_, _ = crand.Read(b[:])
+
+	return binary.LittleEndian.Uint64(b[:])
+}
+
+// Cards return copy of cards left in deck from the top
+func (d *Deck) Cards() []int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	result := make([]int, len(d.cards))
+	copy(result, d.cards)
+
+	return result
+}
+
+func (d *Deck) intN(n int) int {
+	if d.rnd == nil {
+		return rand.IntN(n) // nolint:gosec
+	}
+
+	return d.rnd.IntN(n)
+}
+
+func (d *Deck) shuffle(cards []int) {
+	swap := func(i, j int) { cards[i], cards[j] = cards[j], cards[i] }
+
+	if d.rnd == nil {
+		rand.Shuffle(len(cards), swap)
+		return
+	}
+
+	d.rnd.Shuffle(len(cards), swap)
+}
+
 // GetRandomCard return random card, and remove from deck
 func (d *Deck) GetRandomCard() (int, error) {
 	d.mu.Lock()
 	defer d.mu.Unlock()
 
-	if len(d.cards) == 0 {
+	if !d.ensure(1) {
 		return 0, ErrNoCardsInDeck
 	}
 
-	i := rand.IntN(len(d.cards)) // nolint:gosec
+	i := d.intN(len(d.cards))
 	card := d.cards[i]
 	copy(d.cards[i:], d.cards[i+1:])
 	d.cards = d.cards[:len(d.cards)-1]
@@ -62,7 +134,7 @@ func (d *Deck) Shuffle() {
 	d.mu.Lock()
 	defer d.mu.Unlock()
 
-	rand.Shuffle(len(d.cards), func(i, j int) { d.cards[i], d.cards[j] = d.cards[j], d.cards[i] })
+	d.shuffle(d.cards)
 }
 
 // Top return card from top
@@ -70,7 +142,7 @@ func (d *Deck) Top() (card int, err error) {
 	d.mu.Lock()
 	defer d.mu.Unlock()
 
-	if len(d.cards) == 0 {
+	if !d.ensure(1) {
 		return 0, ErrNoCardsInDeck
 	}
 
@@ -79,7 +151,7 @@ func (d *Deck) Top() (card int, err error) {
 	return card, nil
 }
 
-// GetTopCards return top cards, and remove from deck
+// GetTopCards return top cards, and remove from deck, all cards returned if n is -1
 func (d *Deck) GetTopCards(n int) ([]int, error) {
 	d.mu.Lock()
 	defer d.mu.Unlock()
@@ -88,12 +160,157 @@ func (d *Deck) GetTopCards(n int) ([]int, error) {
 		n = len(d.cards)
 	}
 
+	return d.draw(n)
+}
+
+// Draw return n cards from top, and remove from deck
+// Discard pile shuffled back if deck has not enough cards, nothing removed if there is not enough cards at all
+func (d *Deck) Draw(n int) ([]int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.draw(n)
+}
+
+// Burn move top card to discard pile
+func (d *Deck) Burn() (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if !d.ensure(1) {
+		return 0, ErrNoCardsInDeck
+	}
+
+	card := d.cards[0]
+	d.cards = d.cards[1:]
+	d.discarded = append(d.discarded, card)
+
+	return card, nil
+}
+
+// Cut move cards above position to bottom
+func (d *Deck) Cut(pos int) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if pos < 0 || pos > len(d.cards) {
+		return ErrInvalidPosition
+	}
+
+	d.cards = append(d.cards[pos:len(d.cards):len(d.cards)], d.cards[:pos]...)
+
+	return nil
+}
+
+// Peek return up to n top cards without removing
+func (d *Deck) Peek(n int) []int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	n = max(0, min(n, len(d.cards)))
+	result := make([]int, n)
+	copy(result, d.cards[:n])
+
+	return result
+}
+
+// ReturnTop put cards on top, first given card will be the top one
+func (d *Deck) ReturnTop(cards ...int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	result := make([]int, 0, len(cards)+len(d.cards))
+	result = append(result, cards...)
+	d.cards = append(result, d.cards...)
+}
+
+// ReturnBottom put cards to bottom, last given card will be the bottom one
+func (d *Deck) ReturnBottom(cards ...int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.cards = append(d.cards, cards...)
+}
+
+// ReturnRandom insert every card at random position
+func (d *Deck) ReturnRandom(cards ...int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, c := range cards {
+		i := d.intN(len(d.cards) + 1)
+		d.cards = append(d.cards, 0)
+		copy(d.cards[i+1:], d.cards[i:])
+		d.cards[i] = c
+	}
+}
+
+// Discard put cards to discard pile
+func (d *Deck) Discard(cards ...int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.discarded = append(d.discarded, cards...)
+}
+
+// Discarded return copy of discard pile
+func (d *Deck) Discarded() []int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	result := make([]int, len(d.discarded))
+	copy(result, d.discarded)
+
+	return result
+}
+
+// Len return count of cards left in deck
+func (d *Deck) Len() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return len(d.cards)
+}
+
+// Reset restore all cards in initial order and clear discard pile
+func (d *Deck) Reset() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.cards = make([]int, len(d.initial))
+	copy(d.cards, d.initial)
+	d.discarded = nil
+}
+
+func (d *Deck) draw(n int) ([]int, error) {
 	if n <= 0 {
 		return []int{}, nil
 	}
 
+	if !d.ensure(n) {
+		return nil, ErrNoCardsInDeck
+	}
+
 	result := make([]int, n)
 	copy(result, d.cards[:n])
+	d.cards = d.cards[n:]
 
 	return result, nil
 }
+
+// ensure shuffle discard pile under the deck if deck has less than n cards, false if there is not enough cards
+func (d *Deck) ensure(n int) bool {
+	if len(d.cards) >= n {
+		return true
+	}
+
+	if len(d.cards)+len(d.discarded) < n {
+		return false
+	}
+
+	d.shuffle(d.discarded)
+	d.cards = append(d.cards, d.discarded...)
+	d.discarded = nil
+
+	return true
+}
@@ -0,0 +1,165 @@
+package cards
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/InsideGallery/core/testutils"
+)
+
+func TestDeckWithSeed(t *testing.T) {
+	d1, d2 := NewDeckWithSeed(7), NewDeckWithSeed(7)
+	d1.Shuffle()
+	d2.Shuffle()
+	testutils.Equal(t, d1.Cards(), d2.Cards())
+
+	c1, err := d1.GetRandomCard()
+	testutils.Equal(t, err, nil)
+	c2, err := d2.GetRandomCard()
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, c1, c2)
+
+	d3 := NewDeckWithSeed(8)
+	d3.Shuffle()
+	testutils.Equal(t, slices.Equal(NewDeckWithSeed(7).Cards(), d3.Cards()), false)
+
+	d4 := NewDeckWithSource(rand.NewPCG(7, 7))
+	d4.Shuffle()
+	d5 := NewDeckWithSeed(7)
+	d5.Shuffle()
+	testutils.Equal(t, d4.Cards(), d5.Cards())
+}
+
+func TestSecureDeck(t *testing.T) {
+	d := NewSecureDeck()
+	d.Shuffle()
+
+	cards := d.Cards()
+	testutils.Equal(t, len(cards), len(deck))
+
+	slices.Sort(cards)
+	expected := slices.Clone(deck)
+	slices.Sort(expected)
+	testutils.Equal(t, cards, expected)
+}
+
+func TestFairShuffle(t *testing.T) {
+	f, err := NewFairShuffle("", 1)
+	testutils.Equal(t, err, nil)
+
+	// commitment published before client seed is known
+	commitment := f.Commitment()
+	f.ClientSeed = "client"
+	testutils.Equal(t, f.Commitment(), commitment)
+
+	order := f.Deck().Cards()
+	testutils.Equal(t, order, f.Deck().Cards())
+
+	seed := f.Reveal()
+	testutils.Equal(t, VerifyFairShuffle(commitment, seed, "client", 1, order), nil)
+
+	err = VerifyFairShuffle(commitment, seed, "client", 2, order)
+	testutils.Equal(t, errors.Is(err, ErrShuffleMismatch), true)
+
+	err = VerifyFairShuffle(commitment, []byte("other"), "client", 1, order)
+	testutils.Equal(t, errors.Is(err, ErrCommitmentMismatch), true)
+
+	order[0], order[1] = order[1], order[0]
+	err = VerifyFairShuffle(commitment, seed, "client", 1, order)
+	testutils.Equal(t, errors.Is(err, ErrShuffleMismatch), true)
+
+	other := NewFairShuffleWithSeed(seed, "another client", 1)
+	testutils.Equal(t, slices.Equal(other.Deck().Cards(), f.Deck().Cards()), false)
+}
+
+func TestDeckOperations(t *testing.T) {
+	d := NewDeck()
+
+	top := d.Peek(3)
+	testutils.Equal(t, top, deck[:3])
+	testutils.Equal(t, d.Len(), 52)
+
+	cards, err := d.GetTopCards(2)
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, cards, deck[:2])
+	testutils.Equal(t, d.Len(), 50)
+
+	burned, err := d.Burn()
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, burned, deck[2])
+	testutils.Equal(t, d.Discarded(), []int{deck[2]})
+
+	drawn, err := d.Draw(3)
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, drawn, deck[3:6])
+
+	d.ReturnTop(drawn...)
+	testutils.Equal(t, d.Peek(3), deck[3:6])
+
+	d.ReturnBottom(cards...)
+	all := d.Cards()
+	testutils.Equal(t, all[len(all)-2:], deck[:2])
+
+	testutils.Equal(t, d.Cut(1), nil)
+	testutils.Equal(t, d.Peek(1), []int{deck[4]})
+	testutils.Equal(t, d.Cards()[d.Len()-1], deck[3])
+	testutils.Equal(t, errors.Is(d.Cut(100), ErrInvalidPosition), true)
+	testutils.Equal(t, len(d.Peek(100)), 51)
+
+	d.Reset()
+	testutils.Equal(t, d.Cards(), deck)
+	testutils.Equal(t, len(d.Discarded()), 0)
+
+	rest, err := d.GetTopCards(-1)
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, len(rest), 52)
+	testutils.Equal(t, d.Len(), 0)
+}
+
+func TestDeckReturnRandom(t *testing.T) {
+	d := NewDeckWithSeed(3)
+	cards, err := d.Draw(10)
+	testutils.Equal(t, err, nil)
+
+	d.ReturnRandom(cards...)
+	testutils.Equal(t, d.Len(), 52)
+
+	all := d.Cards()
+	slices.Sort(all)
+	expected := slices.Clone(deck)
+	slices.Sort(expected)
+	testutils.Equal(t, all, expected)
+}
+
+func TestDeckReshuffleDiscard(t *testing.T) {
+	d := NewDeckWithSeed(5)
+
+	first, err := d.Draw(50)
+	testutils.Equal(t, err, nil)
+	d.Discard(first[:10]...)
+
+	_, err = d.Draw(13)
+	testutils.Equal(t, errors.Is(err, ErrNoCardsInDeck), true)
+	testutils.Equal(t, d.Len(), 2)
+
+	second, err := d.Draw(12)
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, second[:2], deck[50:])
+	testutils.Equal(t, len(d.Discarded()), 0)
+
+	rest := slices.Clone(second[2:])
+	slices.Sort(rest)
+	discarded := slices.Clone(first[:10])
+	slices.Sort(discarded)
+	testutils.Equal(t, rest, discarded)
+
+	_, err = d.Top()
+	testutils.Equal(t, errors.Is(err, ErrNoCardsInDeck), true)
+
+	d.Discard(second...)
+	c, err := d.GetRandomCard()
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, slices.Contains(second, c), true)
+}
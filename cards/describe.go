@@ -0,0 +1,256 @@
+package cards
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// HandCategory category of poker hand from high card to royal flush
+type HandCategory uint8
+
+// Hand categories in order of strength
+const (
+	CategoryNone HandCategory = iota
+	HighCard
+	OnePair
+	TwoPair
+	ThreeOfAKind
+	Straight
+	Flush
+	FullHouse
+	FourOfAKind
+	StraightFlush
+	RoyalFlush
+)
+
+var (
+	categoryBits = map[int]HandCategory{
+		highCard: HighCard, onePair: OnePair, twoPair: TwoPair, threeOfAKind: ThreeOfAKind, straight: Straight,
+		flush: Flush, fullHouse: FullHouse, fourOfAKind: FourOfAKind, straightFlush: StraightFlush, royalFlush: RoyalFlush,
+	}
+	categoryNames = map[HandCategory]string{
+		HighCard: "High Card", OnePair: "One Pair", TwoPair: "Two Pair", ThreeOfAKind: "Three of a Kind",
+		Straight: "Straight", Flush: "Flush", FullHouse: "Full House", FourOfAKind: "Four of a Kind",
+		StraightFlush: "Straight Flush", RoyalFlush: "Royal Flush",
+	}
+	// categoryGroups count of ranks which make category, the rest are kickers
+	categoryGroups = map[HandCategory]int{
+		HighCard: 1, OnePair: 1, TwoPair: 2, ThreeOfAKind: 1, Straight: 1,
+		Flush: 5, FullHouse: 2, FourOfAKind: 1, StraightFlush: 1, RoyalFlush: 1,
+	}
+)
+
+// String return name of category
+func (c HandCategory) String() string {
+	return categoryNames[c]
+}
+
+// HandStrength comparable description of hand strength: category and significant ranks,
+// groups go first by size, then kickers, straights keep only the top rank, unused ranks are RankNone
+type HandStrength struct {
+	Category HandCategory
+	Ranks    [5]Rank
+}
+
+// HandStrengthFromScore return hand strength by score of evaluator
+func HandStrengthFromScore(score int) HandStrength {
+	h := HandStrength{Category: categoryBits[score>>scoreShift]}
+	ranks := score & (1<<scoreShift - 1)
+
+	switch h.Category {
+	case CategoryNone:
+		return HandStrength{}
+	case Straight, StraightFlush, RoyalFlush:
+		h.Ranks[0] = nibbleRank(ranks)
+		return h
+	}
+
+	for i := range h.Ranks {
+		h.Ranks[i] = nibbleRank(ranks >> (rankBits * (len(h.Ranks) - 1 - i)))
+	}
+
+	return h
+}
+
+// Compare return -1 if hand is weaker than other, 1 if stronger and 0 for split
+func (h HandStrength) Compare(o HandStrength) int {
+	if c := cmp.Compare(h.Category, o.Category); c != 0 {
+		return c
+	}
+
+	for i := range h.Ranks {
+		if c := cmp.Compare(h.Ranks[i], o.Ranks[i]); c != 0 {
+			return c
+		}
+	}
+
+	return 0
+}
+
+// Main return ranks which make category
+func (h HandStrength) Main() []Rank {
+	return trimRanks(h.Ranks[:categoryGroups[h.Category]])
+}
+
+// Kickers return ranks of kickers
+func (h HandStrength) Kickers() []Rank {
+	return trimRanks(h.Ranks[categoryGroups[h.Category]:])
+}
+
+// String return text like "Two Pair, Kings and Fives with an Ace kicker"
+func (h HandStrength) String() string {
+	main := h.Main()
+	if len(main) == 0 {
+		return h.Category.String()
+	}
+
+	var text string
+
+	switch h.Category {
+	case RoyalFlush:
+		return h.Category.String()
+	case Straight, StraightFlush:
+		return fmt.Sprintf("%s, %s high", h.Category, rankNames[main[0]])
+	case Flush:
+		return fmt.Sprintf("%s, %s", h.Category, joinRanks(main, false))
+	case FullHouse:
+		text = fmt.Sprintf("%s, %s full of %s", h.Category, pluralRank(main[0]), pluralRank(main[1]))
+	case HighCard:
+		text = fmt.Sprintf("%s, %s", h.Category, rankNames[main[0]])
+	default:
+		text = fmt.Sprintf("%s, %s", h.Category, joinRanks(main, true))
+	}
+
+	switch kickers := h.Kickers(); len(kickers) {
+	case 0:
+	case 1:
+		text += fmt.Sprintf(" with %s %s kicker", article(kickers[0]), rankNames[kickers[0]])
+	default:
+		text += fmt.Sprintf(" with %s kickers", joinRanks(kickers, false))
+	}
+
+	return text
+}
+
+// Strength return comparable hand strength of combination
+func (c *Combination) Strength() HandStrength {
+	if len(c.Cards) == 0 {
+		return HandStrength{}
+	}
+
+	return HandStrengthFromScore(c.Score())
+}
+
+// Describe return human readable description of combination
+func (c *Combination) Describe() string {
+	return c.Strength().String()
+}
+
+// combinationJSON JSON representation of combination
+type combinationJSON struct {
+	Combination string   `json:"combination"`
+	Cards       []string `json:"cards"`
+	Kickers     []string `json:"kickers"`
+	Description string   `json:"description,omitempty"`
+}
+
+// MarshalJSON encode combination with card names and description, combination is encoded by pointer
+func (c *Combination) MarshalJSON() ([]byte, error) {
+	return json.Marshal(combinationJSON{
+		Combination: GetCombinationName(c.Combination),
+		Cards:       GetCardsNames(c.Cards),
+		Kickers:     GetCardsNames(c.Kickers),
+		Description: c.Describe(),
+	})
+}
+
+// UnmarshalJSON decode combination from card names, weights are calculated from cards
+func (c *Combination) UnmarshalJSON(data []byte) error {
+	var v combinationJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	combination := GetCombinationID(v.Combination)
+	if combination == 0 && v.Combination != "" {
+		return fmt.Errorf("%w: unknown combination %q", ErrInvalidHand, v.Combination)
+	}
+
+	cards, err := ParseCardNames(v.Cards)
+	if err != nil {
+		return err
+	}
+
+	kickers, err := ParseCardNames(v.Kickers)
+	if err != nil {
+		return err
+	}
+
+	*c = Combination{
+		Combination:   combination,
+		Weight:        cardsWeight(cards),
+		KickersWeight: cardsWeight(kickers),
+		Cards:         cards,
+		Kickers:       kickers,
+	}
+
+	return nil
+}
+
+// nibbleRank return rank packed to lowest bits of score
+func nibbleRank(score int) Rank {
+	n := score & (1<<rankBits - 1)
+	if n == 0 {
+		return RankNone
+	}
+
+	return Two + Rank(n-1) //nolint:gosec
+}
+
+func trimRanks(ranks []Rank) []Rank {
+	var result []Rank
+
+	for _, r := range ranks {
+		if r != RankNone {
+			result = append(result, r)
+		}
+	}
+
+	return result
+}
+
+// joinRanks return ranks like "Ace, King and Nine", plural names used for groups
+func joinRanks(ranks []Rank, plural bool) string {
+	names := make([]string, len(ranks))
+
+	for i, r := range ranks {
+		names[i] = rankNames[r]
+		if plural {
+			names[i] = pluralRank(r)
+		}
+	}
+
+	if len(names) == 1 {
+		return names[0]
+	}
+
+	return strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1]
+}
+
+func pluralRank(r Rank) string {
+	if r == Six {
+		return "Sixes"
+	}
+
+	return rankNames[r] + "s"
+}
+
+func article(r Rank) string {
+	if r == Ace || r == Eight {
+		return "an"
+	}
+
+	return "a"
+}
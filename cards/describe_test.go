@@ -0,0 +1,66 @@
+package cards
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/InsideGallery/core/testutils"
+)
+
+func TestDescribe(t *testing.T) {
+	testcases := []struct {
+		hand     string
+		expected string
+	}{
+		{hand: "Kh Kd 5c 5s Ah 2c 3d", expected: "Two Pair, Kings and Fives with an Ace kicker"},
+		{hand: "Kh 9d 5c 4s Ah 2c 7d", expected: "High Card, Ace with King, Nine, Seven and Five kickers"},
+		{hand: "6h 6d 5c 4s Ah Tc 7d", expected: "One Pair, Sixes with Ace, Ten and Seven kickers"},
+		{hand: "7h 7d 7c 4s Ah Tc 2d", expected: "Three of a Kind, Sevens with Ace and Ten kickers"},
+		{hand: "Ah 2d 3c 4s 5h Kc Kd", expected: "Straight, Five high"},
+		{hand: "Ah Jh 3h 4h 9h Kc Kd", expected: "Flush, Ace, Jack, Nine, Four and Three"},
+		{hand: "Kh Kd Kc 5s 5h 5c 2d", expected: "Full House, Kings full of Fives"},
+		{hand: "Qh Qd Qc Qs 8h 5c 2d", expected: "Four of a Kind, Queens with an Eight kicker"},
+		{hand: "9h 8h 7h 6h 5h 5c 2d", expected: "Straight Flush, Nine high"},
+		{hand: "Ah Kh Qh Jh Th 5c 2d", expected: "Royal Flush"},
+	}
+
+	for _, tc := range testcases {
+		c := BinaryEvaluation{}.Execute(MustParseCards(tc.hand))
+		testutils.Equal(t, c.Describe(), tc.expected)
+		testutils.Equal(t, HandStrengthFromScore(LookupEvaluation{}.Evaluate(MustParseCards(tc.hand))), c.Strength())
+	}
+
+	testutils.Equal(t, (&Combination{}).Describe(), "")
+}
+
+func TestHandStrength(t *testing.T) {
+	twoPair := BinaryEvaluation{}.Execute(MustParseCards("Kh Kd 5c 5s Ah")).Strength()
+	testutils.Equal(t, twoPair, HandStrength{Category: TwoPair, Ranks: [5]Rank{King, Five, Ace}})
+	testutils.Equal(t, twoPair.Main(), []Rank{King, Five})
+	testutils.Equal(t, twoPair.Kickers(), []Rank{Ace})
+
+	weaker := BinaryEvaluation{}.Execute(MustParseCards("Kh Kd 5c 5s Qh")).Strength()
+	testutils.Equal(t, twoPair.Compare(weaker), 1)
+	testutils.Equal(t, weaker.Compare(twoPair), -1)
+	testutils.Equal(t, twoPair.Compare(twoPair), 0)
+	testutils.Equal(t, twoPair == BinaryEvaluation{}.Execute(MustParseCards("Ks Kc 5h 5d Ad")).Strength(), true)
+}
+
+func TestCombinationJSON(t *testing.T) {
+	c := BinaryEvaluation{}.Execute(MustParseCards("Kh Kd 5c 5s Ah 2c 3d"))
+
+	data, err := json.Marshal(c)
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, string(data), `{"combination":"twoPair","cards":["Kh","Kd","5c","5s"],"kickers":["Ah"],`+
+		`"description":"Two Pair, Kings and Fives with an Ace kicker"}`)
+
+	var decoded Combination
+
+	testutils.Equal(t, json.Unmarshal(data, &decoded), nil)
+	testutils.Equal(t, decoded.Score(), c.Score())
+	testutils.Equal(t, decoded.Weight, c.Weight)
+	testutils.Equal(t, decoded.KickersWeight, c.KickersWeight)
+
+	testutils.Equal(t, json.Unmarshal([]byte(`{"combination":"twoPair","cards":["Kx"]}`), &decoded) != nil, true)
+	testutils.Equal(t, json.Unmarshal([]byte(`{"combination":"unknown"}`), &decoded) != nil, true)
+}
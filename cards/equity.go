@@ -0,0 +1,404 @@
+package cards
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"runtime"
+	"slices"
+	"sync"
+)
+
+const (
+	boardSize = 5
+
+	DefaultIterations = 100000
+	DefaultExactLimit = 200000
+)
+
+var (
+	ErrNotEnoughPlayers = errors.New("at least two players required")
+	ErrInvalidHand      = errors.New("invalid hand")
+	ErrInvalidBoard     = errors.New("invalid board")
+	ErrDuplicateCard    = errors.New("duplicate card")
+	ErrInvalidCard      = errors.New("invalid card")
+)
+
+// EquityRequest describe known cards and settings of equity calculation
+type EquityRequest struct {
+	Hands      [][]int   // hole cards of players
+	Board      []int     // known board cards, up to 5
+	Dead       []int     // cards removed from the deck
+	Evaluator  Evaluator // LookupEvaluation by default
+	ExactLimit int       // enumerate all boards if their count not greater, DefaultExactLimit if zero
+	Iterations int       // count of Monte Carlo samples, DefaultIterations if zero
+	Seed       uint64    // seed of Monte Carlo samples
+	Workers    int       // count of goroutines, GOMAXPROCS if zero
+}
+
+// PlayerEquity contains chances of player in percents
+type PlayerEquity struct {
+	Win    float64
+	Tie    float64
+	Lose   float64
+	Equity float64 // win plus share of split pots
+	Outs   []int   // cards of the next street which make player the only winner, when player is behind
+}
+
+// EquityResult contains equity of every player in order of request hands
+type EquityResult struct {
+	Players []PlayerEquity
+	Exact   bool
+	Samples int
+}
+
+// tally contains counters of single worker
+type tally struct {
+	win    []int
+	tie    []int
+	lose   []int
+	equity []float64
+	total  int
+}
+
+func newTally(players int) *tally {
+	return &tally{
+		win:    make([]int, players),
+		tie:    make([]int, players),
+		lose:   make([]int, players),
+		equity: make([]float64, players),
+	}
+}
+
+func (t *tally) merge(o *tally) {
+	for i := range t.win {
+		t.win[i] += o.win[i]
+		t.tie[i] += o.tie[i]
+		t.lose[i] += o.lose[i]
+		t.equity[i] += o.equity[i]
+	}
+
+	t.total += o.total
+}
+
+// showdown contains buffers to evaluate players hands without allocations
+type showdown struct {
+	ev     Evaluator
+	hands  [][]int
+	board  []int
+	cards  [][]int
+	scores []int
+}
+
+func newShowdown(ev Evaluator, hands [][]int, board []int) *showdown {
+	s := &showdown{
+		ev:     ev,
+		hands:  hands,
+		board:  make([]int, boardSize),
+		cards:  make([][]int, len(hands)),
+		scores: make([]int, len(hands)),
+	}
+
+	copy(s.board, board)
+
+	for i, h := range hands {
+		s.cards[i] = make([]int, len(h)+boardSize)
+		copy(s.cards[i], h)
+	}
+
+	return s
+}
+
+// count evaluate hands with current board and add result to tally
+func (s *showdown) count(t *tally) {
+	best, winners := 0, 0
+
+	for i, h := range s.hands {
+		copy(s.cards[i][len(h):], s.board)
+		s.scores[i] = s.ev.Evaluate(s.cards[i])
+
+		switch {
+		case s.scores[i] > best:
+			best, winners = s.scores[i], 1
+		case s.scores[i] == best:
+			winners++
+		}
+	}
+
+	for i, score := range s.scores {
+		switch {
+		case score != best:
+			t.lose[i]++
+		case winners == 1:
+			t.win[i]++
+			t.equity[i]++
+		default:
+			t.tie[i]++
+			t.equity[i] += 1 / float64(winners)
+		}
+	}
+
+	t.total++
+}
+
+// CalculateEquity calculate win, tie and lose chances of every hand
+// All boards enumerated if their count not greater than ExactLimit, otherwise Monte Carlo samples used
+// Result of Monte Carlo is deterministic for the same seed and count of workers
+func CalculateEquity(req EquityRequest) (*EquityResult, error) {
+	stock, err := validateEquity(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Evaluator == nil {
+		req.Evaluator = NewLookupEvaluation()
+	}
+
+	if req.ExactLimit == 0 {
+		req.ExactLimit = DefaultExactLimit
+	}
+
+	if req.Iterations <= 0 {
+		req.Iterations = DefaultIterations
+	}
+
+	if req.Workers <= 0 {
+		req.Workers = runtime.GOMAXPROCS(0)
+	}
+
+	missing := boardSize - len(req.Board)
+	combinations := binomial(len(stock), missing)
+	exact := combinations <= req.ExactLimit
+
+	if !exact {
+		req.Workers = min(req.Workers, req.Iterations)
+	}
+
+	tallies := make([]*tally, req.Workers)
+
+	var wg sync.WaitGroup
+
+	for w := range tallies {
+		tallies[w] = newTally(len(req.Hands))
+
+		wg.Add(1)
+
+		go func(w int) {
+			defer wg.Done()
+
+			s := newShowdown(req.Evaluator, req.Hands, req.Board)
+			if exact {
+				enumerateBoards(s, stock, len(req.Board), w, req.Workers, tallies[w])
+			} else {
+				sampleBoards(s, stock, len(req.Board), req.Iterations, req.Seed, w, req.Workers, tallies[w])
+			}
+		}(w)
+	}
+
+	wg.Wait()
+
+	total := newTally(len(req.Hands))
+	for _, t := range tallies {
+		total.merge(t)
+	}
+
+	result := &EquityResult{
+		Players: make([]PlayerEquity, len(req.Hands)),
+		Exact:   exact,
+		Samples: total.total,
+	}
+
+	for i := range result.Players {
+		result.Players[i] = PlayerEquity{
+			Win:    percent(float64(total.win[i]), total.total),
+			Tie:    percent(float64(total.tie[i]), total.total),
+			Lose:   percent(float64(total.lose[i]), total.total),
+			Equity: percent(total.equity[i], total.total),
+		}
+	}
+
+	if len(req.Board) >= 3 && len(req.Board) < boardSize { //nolint:mnd
+		for i, outs := range calculateOuts(req.Evaluator, req.Hands, req.Board, stock) {
+			result.Players[i].Outs = outs
+		}
+	}
+
+	return result, nil
+}
+
+// enumerateBoards evaluate every w-th combination of missing board cards
+func enumerateBoards(s *showdown, stock []int, known, w, workers int, t *tally) {
+	index := 0
+
+	var walk func(start, pos int)
+	walk = func(start, pos int) {
+		if pos == boardSize {
+			if index%workers == w {
+				s.count(t)
+			}
+
+			index++
+
+			return
+		}
+
+		for i := start; i <= len(stock)-(boardSize-pos); i++ {
+			s.board[pos] = stock[i]
+			walk(i+1, pos+1)
+		}
+	}
+
+	walk(0, known)
+}
+
+// sampleBoards evaluate share of random boards, every worker has own random source
+func sampleBoards(s *showdown, stock []int, known, iterations int, seed uint64, w, workers int, t *tally) {
+	r := rand.New(rand.NewPCG(seed, uint64(w))) //nolint:gosec
+	cards := make([]int, len(stock))
+	copy(cards, stock)
+
+	n := iterations / workers
+	if w < iterations%workers {
+		n++
+	}
+
+	for range n {
+		for pos := known; pos < boardSize; pos++ {
+			j := pos - known + r.IntN(len(cards)-(pos-known))
+			cards[pos-known], cards[j] = cards[j], cards[pos-known]
+			s.board[pos] = cards[pos-known]
+		}
+
+		s.count(t)
+	}
+}
+
+// calculateOuts return cards of the next street which make player the only winner while behind
+func calculateOuts(ev Evaluator, hands [][]int, board, stock []int) [][]int {
+	s := newShowdown(ev, hands, nil)
+	outs := make([][]int, len(hands))
+
+	current := make([]int, len(hands))
+	for i, h := range hands {
+		cards := s.cards[i][:len(h)+len(board)]
+		copy(cards[len(h):], board)
+		current[i] = ev.Evaluate(cards)
+	}
+
+	for i := range hands {
+		behind := false
+
+		for j := range hands {
+			if j != i && current[j] >= current[i] {
+				behind = true
+			}
+		}
+
+		if !behind {
+			continue
+		}
+
+		for _, c := range stock {
+			if soleWinner(s, hands, board, c) == i {
+				outs[i] = append(outs[i], c)
+			}
+		}
+	}
+
+	return outs
+}
+
+// soleWinner return index of the only winner with board and extra card, -1 if pot is split
+func soleWinner(s *showdown, hands [][]int, board []int, extra int) int {
+	winner, best := -1, 0
+
+	for i, h := range hands {
+		cards := s.cards[i][:len(h)+len(board)+1]
+		copy(cards[len(h):], board)
+		cards[len(cards)-1] = extra
+		score := s.ev.Evaluate(cards)
+
+		switch {
+		case score > best:
+			winner, best = i, score
+		case score == best:
+			winner = -1
+		}
+	}
+
+	return winner
+}
+
+// validateEquity check cards and return cards left in the deck
+func validateEquity(req EquityRequest) ([]int, error) {
+	if len(req.Hands) < 2 { //nolint:mnd
+		return nil, ErrNotEnoughPlayers
+	}
+
+	for i, h := range req.Hands {
+		if len(h) == 0 {
+			return nil, fmt.Errorf("%w: player %d has no cards", ErrInvalidHand, i)
+		}
+	}
+
+	return knownStock(req.Board, append(slices.Clone(req.Hands), req.Dead)...)
+}
+
+// knownStock check board and known cards and return cards left in deck
+func knownStock(board []int, known ...[]int) ([]int, error) {
+	if len(board) > boardSize {
+		return nil, fmt.Errorf("%w: %d cards", ErrInvalidBoard, len(board))
+	}
+
+	used := map[int]bool{}
+
+	for _, cards := range append(known, board) {
+		for _, c := range cards {
+			if _, exists := cardsNames[c]; !exists {
+				return nil, fmt.Errorf("%w: %d", ErrInvalidCard, c)
+			}
+
+			if used[c] {
+				return nil, fmt.Errorf("%w: %s", ErrDuplicateCard, GetCardName(c))
+			}
+
+			used[c] = true
+		}
+	}
+
+	stock := make([]int, 0, len(deck)-len(used))
+
+	for _, c := range deck {
+		if !used[c] {
+			stock = append(stock, c)
+		}
+	}
+
+	if len(stock) < boardSize-len(board) {
+		return nil, fmt.Errorf("%w: not enough cards in deck", ErrInvalidBoard)
+	}
+
+	return stock, nil
+}
+
+// binomial return count of k-combinations of n elements
+func binomial(n, k int) int {
+	if k < 0 || k > n {
+		return 0
+	}
+
+	result := 1
+	for i := 1; i <= k; i++ {
+		result = result * (n - k + i) / i
+	}
+
+	return result
+}
+
+func percent(value float64, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+
+	return value * 100 / float64(total) //nolint:mnd
+}
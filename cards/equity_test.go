@@ -0,0 +1,109 @@
+package cards
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/InsideGallery/core/testutils"
+)
+
+func TestEquityExactRiver(t *testing.T) {
+	res, err := CalculateEquity(EquityRequest{
+		Hands: [][]int{GetCardsIDs([]string{"Ah", "Ad"}), GetCardsIDs([]string{"Kh", "Kd"})},
+		Board: GetCardsIDs([]string{"2c", "7s", "9d", "Jc"}),
+	})
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, res.Exact, true)
+	testutils.Equal(t, res.Samples, 44)
+	// two remaining kings
+	testutils.Equal(t, res.Players[1].Win, 2*100/44.0)
+	testutils.Equal(t, res.Players[0].Win, 42*100/44.0)
+	testutils.Equal(t, len(res.Players[1].Outs), 2)
+	testutils.Equal(t, len(res.Players[0].Outs), 0)
+}
+
+func TestEquitySplit(t *testing.T) {
+	res, err := CalculateEquity(EquityRequest{
+		Hands: [][]int{GetCardsIDs([]string{"2h", "3d"}), GetCardsIDs([]string{"2c", "3s"})},
+		Board: GetCardsIDs([]string{"Ah", "Kd", "Qc", "Js", "Td"}),
+	})
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, res.Samples, 1)
+	testutils.Equal(t, res.Players[0].Tie, float64(100))
+	testutils.Equal(t, res.Players[0].Equity, float64(50))
+	testutils.Equal(t, res.Players[1].Equity, float64(50))
+}
+
+func TestEquityWorkersDoNotChangeExactResult(t *testing.T) {
+	req := EquityRequest{
+		Hands: [][]int{
+			GetCardsIDs([]string{"Ah", "Kh"}),
+			GetCardsIDs([]string{"Qs", "Qd"}),
+			GetCardsIDs([]string{"7c", "8c"}),
+		},
+		Board: GetCardsIDs([]string{"Qh", "6c", "2h"}),
+		Dead:  GetCardsIDs([]string{"9c"}),
+	}
+
+	req.Workers = 1
+	single, err := CalculateEquity(req)
+	testutils.Equal(t, err, nil)
+
+	req.Workers = 4
+	multi, err := CalculateEquity(req)
+	testutils.Equal(t, err, nil)
+
+	testutils.Equal(t, single.Samples, binomial(52-6-3-1, 2))
+	testutils.Equal(t, single.Players, multi.Players)
+
+	sum := 0.0
+	for _, p := range single.Players {
+		testutils.Equal(t, math.Abs(p.Win+p.Tie+p.Lose-100) < 1e-9, true)
+		sum += p.Equity
+	}
+
+	testutils.Equal(t, math.Abs(sum-100) < 1e-9, true)
+}
+
+func TestEquityMonteCarlo(t *testing.T) {
+	req := EquityRequest{
+		Hands:      [][]int{GetCardsIDs([]string{"Ah", "As"}), GetCardsIDs([]string{"7c", "2d"})},
+		Iterations: 20000,
+		Seed:       42,
+		Workers:    3,
+	}
+
+	first, err := CalculateEquity(req)
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, first.Exact, false)
+	testutils.Equal(t, first.Samples, 20000)
+
+	second, err := CalculateEquity(req)
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, first.Players, second.Players)
+
+	// aces against seven deuce offsuit win about 88%
+	testutils.Equal(t, math.Abs(first.Players[0].Equity-88) < 2, true)
+}
+
+func TestEquityErrors(t *testing.T) {
+	_, err := CalculateEquity(EquityRequest{Hands: [][]int{GetCardsIDs([]string{"Ah", "As"})}})
+	testutils.Equal(t, errors.Is(err, ErrNotEnoughPlayers), true)
+
+	_, err = CalculateEquity(EquityRequest{
+		Hands: [][]int{GetCardsIDs([]string{"Ah", "As"}), GetCardsIDs([]string{"Ah", "Kd"})},
+	})
+	testutils.Equal(t, errors.Is(err, ErrDuplicateCard), true)
+
+	_, err = CalculateEquity(EquityRequest{
+		Hands: [][]int{GetCardsIDs([]string{"Ah", "As"}), {3}},
+	})
+	testutils.Equal(t, errors.Is(err, ErrInvalidCard), true)
+
+	_, err = CalculateEquity(EquityRequest{
+		Hands: [][]int{GetCardsIDs([]string{"Ah", "As"}), GetCardsIDs([]string{"Kh", "Kd"})},
+		Board: GetCardsIDs([]string{"2c", "3c", "4c", "5c", "6c", "7c"}),
+	})
+	testutils.Equal(t, errors.Is(err, ErrInvalidBoard), true)
+}
@@ -0,0 +1,14 @@
+package cards
+
+// Evaluator describe poker hand evaluator, higher score is better hand, equal scores are split
+type Evaluator interface {
+	Evaluate(cards []int) int
+}
+
+// Evaluate return score of best combination, input cards are not modified
+func (b BinaryEvaluation) Evaluate(cards []int) int {
+	c := make([]int, len(cards))
+	copy(c, cards)
+
+	return b.Execute(c).Score()
+}
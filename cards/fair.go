@@ -0,0 +1,107 @@
+package cards
+
+import (
+	"crypto/hmac"
+	crand "crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"math/rand/v2"
+	"slices"
+)
+
+const serverSeedSize = 32
+
+var (
+	ErrCommitmentMismatch = errors.New("commitment does not match revealed seed")
+	ErrShuffleMismatch    = errors.New("shuffle does not match revealed seed")
+)
+
+// FairShuffle provably fair shuffle by commit and reveal scheme
+// Commitment to server seed published before players choose client seed, server seed revealed after the hand,
+// so players can check that server could not pick its seed knowing their client seed
+type FairShuffle struct {
+	serverSeed []byte
+	ClientSeed string
+	Nonce      uint64 // number of hand played with the same seeds
+}
+
+// NewFairShuffle return fair shuffle with random server seed
+func NewFairShuffle(clientSeed string, nonce uint64) (*FairShuffle, error) {
+	seed := make([]byte, serverSeedSize)
+	if _, err := crand.Read(seed); err != nil {
+		return nil, err
+	}
+
+	return NewFairShuffleWithSeed(seed, clientSeed, nonce), nil
+}
+
+// NewFairShuffleWithSeed return fair shuffle with given server seed
+func NewFairShuffleWithSeed(serverSeed []byte, clientSeed string, nonce uint64) *FairShuffle {
+	return &FairShuffle{
+		serverSeed: slices.Clone(serverSeed),
+		ClientSeed: clientSeed,
+		Nonce:      nonce,
+	}
+}
+
+// Commitment return hex encoded sha256 of server seed, it does not depend on client seed and nonce
+func (f *FairShuffle) Commitment() string {
+	return Commitment(f.serverSeed)
+}
+
+// Reveal return server seed, must be published only after the hand
+func (f *FairShuffle) Reveal() []byte {
+	return slices.Clone(f.serverSeed)
+}
+
+// Deck return deck shuffled by seeds
+func (f *FairShuffle) Deck() *Deck {
+	d := NewDeckWithSource(fairSource(f.serverSeed, f.ClientSeed, f.Nonce))
+	d.Shuffle()
+
+	return d
+}
+
+// Commitment return hex encoded sha256 of server seed
+func Commitment(serverSeed []byte) string {
+	h := sha256.Sum256(serverSeed)
+
+	return hex.EncodeToString(h[:])
+}
+
+// VerifyFairShuffle check revealed server seed against commitment and deck order
+func VerifyFairShuffle(commitment string, serverSeed []byte, clientSeed string, nonce uint64, order []int) error {
+	expected := Commitment(serverSeed)
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(commitment)) != 1 {
+		return ErrCommitmentMismatch
+	}
+
+	if !slices.Equal(NewFairShuffleWithSeed(serverSeed, clientSeed, nonce).Deck().Cards(), order) {
+		return ErrShuffleMismatch
+	}
+
+	return nil
+}
+
+// fairSource return ChaCha8 source keyed by HMAC of client seed and nonce with server seed
+func fairSource(serverSeed []byte, clientSeed string, nonce uint64) rand.Source {
+	mac := hmac.New(sha256.New, serverSeed)
+	mac.Write(fairMessage(clientSeed, nonce))
+
+	var key [32]byte
+
+	copy(key[:], mac.Sum(nil))
+
+	return rand.NewChaCha8(key)
+}
+
+func fairMessage(clientSeed string, nonce uint64) []byte {
+	msg := make([]byte, 0, len(clientSeed)+1+8) //nolint:mnd
+	msg = append(msg, clientSeed...)
+	msg = append(msg, 0)
+
+	return binary.BigEndian.AppendUint64(msg, nonce)
+}
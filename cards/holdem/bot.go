@@ -0,0 +1,215 @@
+package holdem
+
+import (
+	"math/rand/v2"
+	"slices"
+
+	"github.com/InsideGallery/game-core/cards"
+	"github.com/InsideGallery/game-core/engine/communications"
+
+	"github.com/InsideGallery/core/ecs"
+)
+
+// DefaultBotIterations count of Monte Carlo samples used by bot to estimate equity
+const DefaultBotIterations = 1000
+
+// Style describe bot playing style, all values are from 0 to 1
+type Style struct {
+	Looseness  float64 // 0 plays only strong hands, 1 continues with weak hands
+	Aggression float64 // probability to bet or raise with value hand instead of check or call
+	Bluff      float64 // probability to bet or raise with weak hand
+}
+
+// Playing styles
+var (
+	TightAggressive = Style{Looseness: 0.2, Aggression: 0.8, Bluff: 0.05}
+	TightPassive    = Style{Looseness: 0.2, Aggression: 0.2, Bluff: 0.02}
+	LooseAggressive = Style{Looseness: 0.8, Aggression: 0.8, Bluff: 0.2}
+	LoosePassive    = Style{Looseness: 0.8, Aggression: 0.2, Bluff: 0.05}
+)
+
+// Bot computer player, it decides by equity against random hands, pot odds and position
+// and acts through table API as human players do, decisions are deterministic for the same seed and table state
+type Bot struct {
+	*ecs.BaseEntity
+	*communications.CommunicateComponent
+
+	Style      Style
+	Iterations int // DefaultBotIterations if zero
+
+	rnd *rand.Rand
+}
+
+// NewBot return bot with given id, style and seed
+func NewBot(id uint64, style Style, seed uint64) *Bot {
+	return &Bot{
+		BaseEntity:           ecs.NewBaseEntityWithID(id),
+		CommunicateComponent: communications.NewCommunicateComponent(nil),
+		Style:                style,
+		rnd:                  rand.New(rand.NewPCG(seed, id)), //nolint:gosec
+	}
+}
+
+// Act decide and apply action if it is turn of bot
+func (b *Bot) Act(t *Table) error {
+	a, err := b.Decide(t)
+	if err != nil {
+		return err
+	}
+
+	return t.Act(b.GetID(), a)
+}
+
+// Decide return action of bot for current table state
+func (b *Bot) Decide(t *Table) (Action, error) {
+	o, err := t.Options(b.GetID())
+	if err != nil {
+		return Action{}, err
+	}
+
+	seats := t.Seats()
+	board := t.Board()
+	pot := t.Pot()
+	button := t.Button()
+
+	var (
+		self      *Seat
+		opponents int
+	)
+
+	for _, s := range seats {
+		switch {
+		case s == nil || !s.InHand || s.Folded:
+		case s.PlayerID == b.GetID():
+			self = s
+		default:
+			opponents++
+		}
+	}
+
+	if self == nil {
+		return Action{}, ErrNotSeated
+	}
+
+	equity, err := b.equity(self.Hole, board, opponents)
+	if err != nil {
+		return Action{}, err
+	}
+
+	fairShare := 1 / float64(opponents+1)
+	position := b.position(seats, self.Index, button)
+	strong := equity >= fairShare*(1.5-0.5*b.Style.Looseness) //nolint:mnd
+	aggressive := b.rnd.Float64() < b.Style.Aggression
+	bluff := b.rnd.Float64() < b.Style.Bluff*(1+position) //nolint:mnd
+
+	if o.CanRaise && ((strong && aggressive) || (!strong && bluff)) {
+		return b.raise(self, o, pot, equity), nil
+	}
+
+	if o.CanCheck {
+		return Action{Type: Check}, nil
+	}
+
+	// required equity is lower for loose style and late position
+	potOdds := float64(o.CallAmount) / float64(pot+o.CallAmount)
+	required := potOdds*(1.2-0.4*b.Style.Looseness) - 0.05*position //nolint:mnd
+
+	if strong || equity >= required {
+		return Action{Type: Call}, nil
+	}
+
+	return Action{Type: Fold}, nil
+}
+
+// equity return share of pot won against random hands of opponents
+func (b *Bot) equity(hole, board []int, opponents int) (float64, error) {
+	ranges := []cards.Range{{{Cards: [2]int{hole[0], hole[1]}, Weight: 1}}}
+	for range opponents {
+		ranges = append(ranges, anyHand)
+	}
+
+	iterations := b.Iterations
+	if iterations <= 0 {
+		iterations = DefaultBotIterations
+	}
+
+	result, err := cards.CalculateRangeEquity(cards.RangeEquityRequest{
+		Ranges:     ranges,
+		Board:      board,
+		Iterations: iterations,
+		Seed:       b.rnd.Uint64(),
+		Workers:    1,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return result.Players[0].Equity / 100, nil //nolint:mnd
+}
+
+// position return 0 for the first player after the button and 1 for the button
+func (b *Bot) position(seats []*Seat, self, button int) float64 {
+	var order []int
+
+	for i := 1; i <= len(seats); i++ {
+		j := (button + i) % len(seats)
+		if s := seats[j]; s != nil && s.InHand && !s.Folded {
+			order = append(order, j)
+		}
+	}
+
+	if len(order) < 2 { //nolint:mnd
+		return 1
+	}
+
+	return float64(slices.Index(order, self)) / float64(len(order)-1)
+}
+
+// raise return bet or raise sized from half pot to pot by aggression and equity
+func (b *Bot) raise(self *Seat, o Options, pot int, equity float64) Action {
+	current := self.Bet + o.CallAmount
+	size := float64(pot+o.CallAmount) * (0.5 + 0.5*max(b.Style.Aggression, equity)) //nolint:mnd
+	to := min(max(current+int(size), o.MinRaiseTo), o.MaxRaiseTo)
+
+	switch {
+	case to >= self.Bet+self.Stack:
+		return Action{Type: AllIn}
+	case current == 0:
+		return Action{Type: Bet, Amount: to}
+	}
+
+	return Action{Type: Raise, Amount: to}
+}
+
+// PlayBots let bots act while it is turn of one of them, return when human player should act or hand finished
+func PlayBots(t *Table, bots ...*Bot) error {
+	for {
+		id, ok := t.ToAct()
+		if !ok {
+			return nil
+		}
+
+		i := slices.IndexFunc(bots, func(b *Bot) bool { return b.GetID() == id })
+		if i < 0 {
+			return nil
+		}
+
+		if err := bots[i].Act(t); err != nil {
+			return err
+		}
+	}
+}
+
+// anyHand range of all two card hands
+var anyHand = func() cards.Range {
+	var r cards.Range
+
+	deck := cards.NewDeck().Cards()
+	for i := range deck {
+		for j := i + 1; j < len(deck); j++ {
+			r = append(r, cards.Combo{Cards: [2]int{deck[i], deck[j]}, Weight: 1})
+		}
+	}
+
+	return r
+}()
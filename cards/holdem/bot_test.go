@@ -0,0 +1,94 @@
+package holdem
+
+import (
+	"testing"
+
+	"github.com/InsideGallery/game-core/cards"
+
+	"github.com/InsideGallery/core/testutils"
+)
+
+func newBotTable(t *testing.T, styles ...Style) (*Table, []*Bot) {
+	table, err := NewTable(Config{SmallBlind: 1, BigBlind: 2})
+	testutils.Equal(t, err, nil)
+
+	bots := make([]*Bot, len(styles))
+	for i, style := range styles {
+		bots[i] = NewBot(uint64(i+1), style, 42)
+		bots[i].Iterations = 200
+		testutils.Equal(t, table.Sit(i, bots[i], 200), nil)
+	}
+
+	return table, bots
+}
+
+func TestBotsDeterministic(t *testing.T) {
+	play := func() []Event {
+		table, bots := newBotTable(t, TightAggressive, LooseAggressive, TightPassive, LoosePassive)
+
+		seed := uint64(0)
+		table.SetDeckFactory(func() *cards.Deck {
+			seed++
+			d := cards.NewDeckWithSeed(seed)
+			d.Shuffle()
+
+			return d
+		})
+
+		var events []Event
+
+		table.OnEvent(func(e Event) { events = append(events, e) })
+
+		for range 10 {
+			testutils.Equal(t, table.StartHand(), nil)
+			testutils.Equal(t, PlayBots(table, bots...), nil)
+			testutils.Equal(t, table.InHand(), false)
+		}
+
+		total := 0
+		for _, s := range stacks(table) {
+			total += s
+		}
+
+		testutils.Equal(t, total, 800)
+
+		return events
+	}
+
+	testutils.Equal(t, play(), play())
+}
+
+func TestBotDecisions(t *testing.T) {
+	table, bots := newBotTable(t, TightPassive, TightAggressive)
+	table.SetDeckFactory(stackedDeck("Ah", "7c", "Ad", "2d"))
+
+	testutils.Equal(t, table.StartHand(), nil)
+
+	// heads up button posts small blind and acts first with seven deuce
+	_, err := bots[1].Decide(table)
+	testutils.Equal(t, err, ErrNotYourTurn)
+
+	// price is good enough to complete small blind
+	bots[0].Style.Bluff = 0
+	a, err := bots[0].Decide(table)
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, a, Action{Type: Call})
+	testutils.Equal(t, bots[0].Act(table), nil)
+
+	// aces raise when checked to
+	bots[1].Style.Aggression = 1
+	a, err = bots[1].Decide(table)
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, a.Type, Raise)
+	testutils.Equal(t, table.Act(2, Action{Type: Raise, Amount: 60}), nil)
+
+	// seven deuce folds to big raise
+	a, err = bots[0].Decide(table)
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, a, Action{Type: Fold})
+
+	// human player is not driven by bots
+	testutils.Equal(t, PlayBots(table, bots[1]), nil)
+	id, _ := table.ToAct()
+	testutils.Equal(t, id, uint64(1))
+}
@@ -0,0 +1,41 @@
+package holdem
+
+// Limit describe betting structure
+type Limit uint8
+
+// Betting structures
+const (
+	NoLimit Limit = iota
+	PotLimit
+	FixedLimit
+)
+
+const (
+	// DefaultMaxSeats default count of seats at table
+	DefaultMaxSeats = 9
+	// fixedLimitCap count of bet and raises allowed per street in fixed limit
+	fixedLimitCap = 4
+	// deckSize count of cards in standard deck
+	deckSize = 52
+	// boardCards count of community and burned cards dealt during the hand
+	boardCards = 8
+)
+
+// Config describe table stakes and structure
+type Config struct {
+	SmallBlind int
+	BigBlind   int
+	Ante       int
+	Limit      Limit
+	MaxSeats   int   // DefaultMaxSeats if zero
+	MsgType    uint8 // message type of events
+}
+
+// betSize return fixed limit bet size for street: big blind on preflop and flop, double big blind on turn and river
+func (c Config) betSize(s Street) int {
+	if s >= Turn {
+		return c.BigBlind * 2 //nolint:mnd
+	}
+
+	return c.BigBlind
+}
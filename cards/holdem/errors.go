@@ -0,0 +1,22 @@
+package holdem
+
+import "errors"
+
+// All kind of errors for holdem table
+var (
+	ErrInvalidSeat      = errors.New("invalid seat")
+	ErrSeatTaken        = errors.New("seat already taken")
+	ErrAlreadySeated    = errors.New("player already seated")
+	ErrNotSeated        = errors.New("player not seated")
+	ErrNotEnoughPlayers = errors.New("not enough players with chips")
+	ErrHandInProgress   = errors.New("hand in progress")
+	ErrNoHand           = errors.New("hand not started")
+	ErrNotYourTurn      = errors.New("not your turn")
+	ErrInvalidAction    = errors.New("invalid action")
+	ErrBetTooSmall      = errors.New("bet is less than minimum")
+	ErrBetTooLarge      = errors.New("bet is greater than limit")
+	ErrNotEnoughChips   = errors.New("not enough chips")
+	ErrInvalidConfig    = errors.New("invalid table config")
+	ErrInvalidHistory   = errors.New("invalid hand history")
+	ErrNotEnoughCards   = errors.New("not enough cards in deck")
+)
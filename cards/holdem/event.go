@@ -0,0 +1,91 @@
+package holdem
+
+import "encoding/binary"
+
+// Street describe betting round
+type Street uint8
+
+// Streets of hand
+const (
+	Preflop Street = iota
+	Flop
+	Turn
+	River
+	Showdown
+)
+
+// ActionType describe player decision
+type ActionType uint8
+
+// Action types
+const (
+	Fold ActionType = iota + 1
+	Check
+	Call
+	Bet
+	Raise
+	AllIn
+	PostAnte
+	PostSmallBlind
+	PostBigBlind
+)
+
+// Action describe player decision, amount is total street bet for Bet and Raise
+type Action struct {
+	Type   ActionType
+	Amount int
+}
+
+// EventType describe type of table event
+type EventType uint8
+
+// Table event types
+const (
+	EventHandStarted EventType = iota + 1
+	EventPlayerSat
+	EventPlayerLeft
+	EventHoleCards
+	EventAction
+	EventStreet
+	EventTurn
+	EventShowdown
+	EventPotAwarded
+	EventHandEnded
+)
+
+// Event describe table notification, hole cards sent only to their owner
+type Event struct {
+	MsgType  uint8
+	Type     EventType
+	HandID   uint64
+	Seat     int
+	PlayerID uint64
+	Street   Street
+	Action   ActionType
+	Amount   int   // chips of action, award or stack
+	Pot      int   // total chips in pot after event
+	Cards    []int // hole cards, board cards or shown cards
+}
+
+// GetMessageType return message type
+func (e *Event) GetMessageType() uint8 {
+	return e.MsgType
+}
+
+// Encode encode event as: type | event type | hand id | seat | player id | street | action | amount | pot | cards count | cards...
+func (e *Event) Encode() []byte {
+	buf := []byte{e.MsgType, byte(e.Type)}
+	buf = binary.AppendUvarint(buf, e.HandID)
+	buf = binary.AppendVarint(buf, int64(e.Seat))
+	buf = binary.AppendUvarint(buf, e.PlayerID)
+	buf = append(buf, byte(e.Street), byte(e.Action))
+	buf = binary.AppendVarint(buf, int64(e.Amount))
+	buf = binary.AppendVarint(buf, int64(e.Pot))
+	buf = binary.AppendUvarint(buf, uint64(len(e.Cards)))
+
+	for _, c := range e.Cards {
+		buf = binary.AppendUvarint(buf, uint64(c)) //nolint:gosec
+	}
+
+	return buf
+}
@@ -0,0 +1,340 @@
+package holdem
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/InsideGallery/game-core/cards"
+)
+
+// HandCards cards which encoded to JSON by names
+type HandCards []int
+
+// MarshalJSON encode cards as list of names
+func (c HandCards) MarshalJSON() ([]byte, error) {
+	return json.Marshal(cards.GetCardsNames(c))
+}
+
+// UnmarshalJSON decode cards from list of names
+func (c *HandCards) UnmarshalJSON(data []byte) error {
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		return err
+	}
+
+	ids, err := cardIDs(names)
+	if err != nil {
+		return err
+	}
+
+	*c = ids
+
+	return nil
+}
+
+// HistorySeat describe player at the start of the hand
+type HistorySeat struct {
+	Seat     int       `json:"seat"`
+	PlayerID uint64    `json:"player_id"`
+	Stack    int       `json:"stack"`
+	Hole     HandCards `json:"hole,omitempty"`
+}
+
+// HistoryAction describe player action, amount is total street bet or ante
+type HistoryAction struct {
+	Street   Street     `json:"street"`
+	Seat     int        `json:"seat"`
+	PlayerID uint64     `json:"player_id"`
+	Type     ActionType `json:"type"`
+	Amount   int        `json:"amount"`
+}
+
+// HistoryShow describe cards shown at showdown
+type HistoryShow struct {
+	Seat     int       `json:"seat"`
+	PlayerID uint64    `json:"player_id"`
+	Cards    HandCards `json:"cards"`
+}
+
+// HistoryAward describe chips won by player, best is five best cards if hand went to showdown
+type HistoryAward struct {
+	Seat     int       `json:"seat"`
+	PlayerID uint64    `json:"player_id"`
+	Amount   int       `json:"amount"`
+	Best     HandCards `json:"best,omitempty"`
+}
+
+// History full record of one hand
+type History struct {
+	HandID     uint64          `json:"hand_id"`
+	Table      string          `json:"table,omitempty"`
+	Time       time.Time       `json:"time"`
+	Limit      Limit           `json:"limit"`
+	SmallBlind int             `json:"small_blind"`
+	BigBlind   int             `json:"big_blind"`
+	Ante       int             `json:"ante,omitempty"`
+	MaxSeats   int             `json:"max_seats"`
+	Button     int             `json:"button"`
+	Seats      []HistorySeat   `json:"seats"`
+	Actions    []HistoryAction `json:"actions"`
+	Board      HandCards       `json:"board,omitempty"`
+	Showdown   []HistoryShow   `json:"showdown,omitempty"`
+	Awards     []HistoryAward  `json:"awards"`
+}
+
+// JSON return history encoded to JSON
+func (h *History) JSON() ([]byte, error) {
+	return json.Marshal(h)
+}
+
+// ParseHistoryJSON decode history from JSON
+func ParseHistoryJSON(data []byte) (*History, error) {
+	h := &History{}
+	if err := json.Unmarshal(data, h); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidHistory, err)
+	}
+
+	return h, nil
+}
+
+// Pot return total chips awarded in the hand
+func (h *History) Pot() int {
+	pot := 0
+	for _, a := range h.Awards {
+		pot += a.Amount
+	}
+
+	return pot
+}
+
+// Events return table events of the hand in order they were emitted, used to replay hand
+func (h *History) Events() []Event {
+	var (
+		result        []Event
+		contributions = map[int]int{}
+		bets          = map[int]int{}
+		street        = Preflop
+		dealt         = false
+	)
+
+	pot := func() int {
+		total := 0
+		for _, c := range contributions {
+			total += c
+		}
+
+		return total
+	}
+
+	emit := func(e Event) {
+		e.HandID = h.HandID
+		e.Pot = pot()
+		result = append(result, e)
+	}
+
+	deal := func() {
+		if dealt {
+			return
+		}
+
+		dealt = true
+
+		for _, s := range h.dealOrder() {
+			emit(Event{Type: EventHoleCards, Seat: s.Seat, PlayerID: s.PlayerID, Cards: slices.Clone(s.Hole)})
+		}
+	}
+
+	streetTo := func(to Street) {
+		for street < to && int(street)+1 < len(streetCards) && streetCards[street+1] <= len(h.Board) {
+			street++
+			clear(bets)
+			emit(Event{Type: EventStreet, Street: street, Cards: slices.Clone(h.Board[:streetCards[street]])})
+		}
+	}
+
+	emit(Event{Type: EventHandStarted, Seat: h.Button})
+
+	for _, a := range h.Actions {
+		if a.Type < PostAnte {
+			deal()
+		}
+
+		streetTo(a.Street)
+
+		if a.Type == PostAnte {
+			contributions[a.Seat] += a.Amount
+		} else {
+			contributions[a.Seat] += a.Amount - bets[a.Seat]
+			bets[a.Seat] = a.Amount
+		}
+
+		emit(Event{Type: EventAction, Seat: a.Seat, PlayerID: a.PlayerID, Street: a.Street, Action: a.Type, Amount: a.Amount})
+	}
+
+	deal()
+	streetTo(River)
+
+	for _, s := range h.Showdown {
+		emit(Event{Type: EventShowdown, Seat: s.Seat, PlayerID: s.PlayerID, Cards: slices.Clone(s.Cards)})
+	}
+
+	for _, a := range h.Awards {
+		emit(Event{Type: EventPotAwarded, Seat: a.Seat, PlayerID: a.PlayerID, Amount: a.Amount, Cards: slices.Clone(a.Best)})
+	}
+
+	emit(Event{Type: EventHandEnded})
+
+	return result
+}
+
+// streetCards count of board cards on street
+var streetCards = [...]int{Preflop: 0, Flop: 3, Turn: 4, River: 5}
+
+// dealOrder return seats starting after the button
+func (h *History) dealOrder() []HistorySeat {
+	i := slices.IndexFunc(h.Seats, func(s HistorySeat) bool { return s.Seat > h.Button })
+	if i < 0 {
+		i = 0
+	}
+
+	return append(slices.Clone(h.Seats[i:]), h.Seats[:i]...)
+}
+
+// seat return seat of player by seat index
+func (h *History) seat(index int) *HistorySeat {
+	for i := range h.Seats {
+		if h.Seats[i].Seat == index {
+			return &h.Seats[i]
+		}
+	}
+
+	return nil
+}
+
+// Recorder collect history of every hand played at table
+type Recorder struct {
+	table     *Table
+	current   *History
+	street    Street
+	histories []*History
+	now       func() time.Time
+	mu        sync.Mutex
+}
+
+// NewRecorder return recorder subscribed to table events
+func NewRecorder(t *Table) *Recorder {
+	r := &Recorder{
+		table: t,
+		now:   func() time.Time { return time.Now().UTC() },
+	}
+
+	t.OnEvent(r.record)
+
+	return r
+}
+
+// Histories return histories of finished hands
+func (r *Recorder) Histories() []*History {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return slices.Clone(r.histories)
+}
+
+// Last return history of the last finished hand, nil if there is no one
+func (r *Recorder) Last() *History {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.histories) == 0 {
+		return nil
+	}
+
+	return r.histories[len(r.histories)-1]
+}
+
+// record handle table event, called while table is locked so seats are read directly
+func (r *Recorder) record(e Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if e.Type == EventHandStarted {
+		r.start(e)
+		return
+	}
+
+	h := r.current
+	if h == nil {
+		return
+	}
+
+	switch e.Type {
+	case EventHoleCards:
+		if s := h.seat(e.Seat); s != nil {
+			s.Hole = slices.Clone(e.Cards)
+		}
+	case EventAction:
+		h.Actions = append(h.Actions, HistoryAction{
+			Street:   r.street,
+			Seat:     e.Seat,
+			PlayerID: e.PlayerID,
+			Type:     e.Action,
+			Amount:   e.Amount,
+		})
+	case EventStreet:
+		r.street = e.Street
+		h.Board = slices.Clone(e.Cards)
+	case EventShowdown:
+		h.Showdown = append(h.Showdown, HistoryShow{Seat: e.Seat, PlayerID: e.PlayerID, Cards: slices.Clone(e.Cards)})
+	case EventPotAwarded:
+		h.Awards = append(h.Awards, HistoryAward{
+			Seat:     e.Seat,
+			PlayerID: e.PlayerID,
+			Amount:   e.Amount,
+			Best:     slices.Clone(e.Cards),
+		})
+	case EventHandEnded:
+		r.histories = append(r.histories, h)
+		r.current = nil
+	}
+}
+
+func (r *Recorder) start(e Event) {
+	cfg := r.table.cfg
+
+	h := &History{
+		HandID:     e.HandID,
+		Time:       r.now(),
+		Limit:      cfg.Limit,
+		SmallBlind: cfg.SmallBlind,
+		BigBlind:   cfg.BigBlind,
+		Ante:       cfg.Ante,
+		MaxSeats:   cfg.MaxSeats,
+		Button:     e.Seat,
+	}
+
+	for _, s := range r.table.seats {
+		if s != nil && s.InHand {
+			h.Seats = append(h.Seats, HistorySeat{Seat: s.Index, PlayerID: s.PlayerID, Stack: s.Stack})
+		}
+	}
+
+	r.current = h
+	r.street = Preflop
+}
+
+// cardIDs return cards by names, error if name is unknown
+func cardIDs(names []string) ([]int, error) {
+	ids := cards.GetCardsIDs(names)
+
+	for i, id := range ids {
+		if id == 0 {
+			return nil, fmt.Errorf("%w: card %q", ErrInvalidHistory, names[i])
+		}
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,130 @@
+package holdem
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/InsideGallery/game-core/cards"
+
+	"github.com/InsideGallery/core/testutils"
+)
+
+func playRecordedHand(t *testing.T) (*Recorder, []Event) {
+	table, _ := newTestTable(t, Config{SmallBlind: 1, BigBlind: 2, Ante: 1}, 100, 100, 40)
+	table.SetDeckFactory(stackedDeck(
+		"2c", "Ah", "Kh", "3d", "Ad", "Kd",
+		"4s", "7c", "8d", "9s", "4h", "Js", "4d", "2h",
+	))
+
+	recorder := NewRecorder(table)
+	recorder.now = func() time.Time { return time.Date(2026, 10, 19, 6, 30, 0, 0, time.UTC) }
+
+	var events []Event
+
+	table.OnEvent(func(e Event) {
+		if e.Type != EventTurn {
+			events = append(events, e)
+		}
+	})
+
+	testutils.Equal(t, table.StartHand(), nil)
+	testutils.Equal(t, table.Act(1, Action{Type: Raise, Amount: 6}), nil)
+	testutils.Equal(t, table.Act(2, Action{Type: Call}), nil)
+	testutils.Equal(t, table.Act(3, Action{Type: Call}), nil)
+	testutils.Equal(t, table.Act(2, Action{Type: Check}), nil)
+	testutils.Equal(t, table.Act(3, Action{Type: Bet, Amount: 10}), nil)
+	testutils.Equal(t, table.Act(1, Action{Type: Fold}), nil)
+	testutils.Equal(t, table.Act(2, Action{Type: Raise, Amount: 30}), nil)
+	testutils.Equal(t, table.Act(3, Action{Type: AllIn}), nil)
+	testutils.Equal(t, table.Act(2, Action{Type: Call}), nil)
+	testutils.Equal(t, table.InHand(), false)
+
+	return recorder, events
+}
+
+func TestRecorder(t *testing.T) {
+	recorder, events := playRecordedHand(t)
+	testutils.Equal(t, len(recorder.Histories()), 1)
+
+	h := recorder.Last()
+	testutils.Equal(t, h.HandID, uint64(1))
+	testutils.Equal(t, h.Seats[2], HistorySeat{Seat: 2, PlayerID: 3, Stack: 40, Hole: HandCards(cards.GetCardsIDs([]string{"Ah", "Ad"}))})
+	testutils.Equal(t, len(h.Board), 5)
+	testutils.Equal(t, len(h.Showdown), 2)
+	testutils.Equal(t, h.Pot(), 3+6*3+33*2)
+
+	// replayed events match events emitted by table
+	replay := h.Events()
+	testutils.Equal(t, len(replay), len(events))
+
+	for i, e := range replay {
+		e.Street, events[i].Street = 0, 0
+		testutils.Equal(t, e, events[i])
+	}
+}
+
+func TestHistoryText(t *testing.T) {
+	recorder, _ := playRecordedHand(t)
+	h := recorder.Last()
+
+	text := h.Text()
+	expected := `PokerStars Hand #1: Hold'em No Limit (1/2) Ante 1 - 2026/10/19 06:30:00 UTC
+Table '' 9-max Seat #1 is the button
+Seat 1: 1 (100 in chips)
+Seat 2: 2 (100 in chips)
+Seat 3: 3 (40 in chips)
+2: posts the ante 1
+3: posts the ante 1
+1: posts the ante 1
+2: posts small blind 1
+3: posts big blind 2
+*** HOLE CARDS ***
+Dealt to 2 [2c 3d]
+Dealt to 3 [Ah Ad]
+Dealt to 1 [Kh Kd]
+1: raises 4 to 6
+2: calls 5
+3: calls 4
+*** FLOP *** [7c 8d 9s]
+2: checks
+3: bets 10
+1: folds
+2: raises 20 to 30
+3: raises 3 to 33 and is all-in
+2: calls 3
+*** TURN *** [7c 8d 9s] [Js]
+*** RIVER *** [7c 8d 9s Js] [2h]
+*** SHOW DOWN ***
+2: shows [2c 3d]
+3: shows [Ah Ad]
+3 collected 87 from pot
+*** SUMMARY ***
+Total pot 87
+Board [7c 8d 9s Js 2h]
+Seat 3: 3 showed [Ah Ad] and won (87) with [Ah Ad 8d 9s Js]
+`
+	testutils.Equal(t, text, expected)
+
+	parsed, err := ParseHistory(text)
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, parsed, h)
+
+	_, err = ParseHistory("PokerStars Hand #1: Hold'em\nunknown line")
+	testutils.Equal(t, errors.Is(err, ErrInvalidHistory), true)
+}
+
+func TestHistoryJSON(t *testing.T) {
+	recorder, _ := playRecordedHand(t)
+	h := recorder.Last()
+
+	data, err := h.JSON()
+	testutils.Equal(t, err, nil)
+
+	parsed, err := ParseHistoryJSON(data)
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, parsed, h)
+
+	_, err = ParseHistoryJSON([]byte(`{"board":["Zz"]}`))
+	testutils.Equal(t, errors.Is(err, ErrInvalidHistory), true)
+}
@@ -0,0 +1,387 @@
+package holdem
+
+import (
+	"bufio"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/InsideGallery/game-core/cards"
+)
+
+// historyTimeLayout time format of hand history header
+const historyTimeLayout = "2006/01/02 15:04:05"
+
+var (
+	limitNames  = map[Limit]string{NoLimit: "No Limit", PotLimit: "Pot Limit", FixedLimit: "Limit"}
+	streetNames = map[Street]string{Flop: "FLOP", Turn: "TURN", River: "RIVER"}
+	postNames   = map[ActionType]string{PostAnte: "the ante", PostSmallBlind: "small blind", PostBigBlind: "big blind"}
+
+	headerLine  = regexp.MustCompile(`^PokerStars Hand #(\d+): Hold'em (No Limit|Pot Limit|Limit) \((\d+)/(\d+)\)(?: Ante (\d+))? - (.+) UTC$`)
+	tableLine   = regexp.MustCompile(`^Table '(.*)' (\d+)-max Seat #(\d+) is the button$`)
+	seatLine    = regexp.MustCompile(`^Seat (\d+): (\d+) \((\d+) in chips\)$`)
+	postLine    = regexp.MustCompile(`^(\d+): posts (the ante|small blind|big blind) (\d+)( and is all-in)?$`)
+	dealtLine   = regexp.MustCompile(`^Dealt to (\d+) \[(.+)\]$`)
+	actionLine  = regexp.MustCompile(`^(\d+): (folds|checks|calls (\d+)|bets (\d+)|raises (\d+) to (\d+))( and is all-in)?$`)
+	streetLine  = regexp.MustCompile(`^\*\*\* (FLOP|TURN|RIVER) \*\*\* (.+)$`)
+	showLine    = regexp.MustCompile(`^(\d+): shows \[(.+)\]$`)
+	awardLine   = regexp.MustCompile(`^Seat (\d+): (\d+) (?:showed \[[^\]]+\] and won|collected) \((\d+)\)(?: with \[(.+)\])?$`)
+	cardsGroups = regexp.MustCompile(`\[([^\]]+)\]`)
+)
+
+// Text return history in PokerStars like text format
+// Players named by their ids, seats numbered from one and hole cards of all players are written
+func (h *History) Text() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "PokerStars Hand #%d: Hold'em %s (%d/%d)", h.HandID, limitNames[h.Limit], h.SmallBlind, h.BigBlind)
+
+	if h.Ante > 0 {
+		fmt.Fprintf(&b, " Ante %d", h.Ante)
+	}
+
+	fmt.Fprintf(&b, " - %s UTC\n", h.Time.UTC().Format(historyTimeLayout))
+	fmt.Fprintf(&b, "Table '%s' %d-max Seat #%d is the button\n", h.Table, h.MaxSeats, h.Button+1)
+
+	for _, s := range h.Seats {
+		fmt.Fprintf(&b, "Seat %d: %d (%d in chips)\n", s.Seat+1, s.PlayerID, s.Stack)
+	}
+
+	var (
+		street     = Preflop
+		bets       = map[int]int{}
+		currentBet = 0
+		holeCards  = false
+	)
+
+	writeHoleCards := func() {
+		if holeCards {
+			return
+		}
+
+		holeCards = true
+
+		b.WriteString("*** HOLE CARDS ***\n")
+
+		for _, s := range h.dealOrder() {
+			if len(s.Hole) > 0 {
+				fmt.Fprintf(&b, "Dealt to %d [%s]\n", s.PlayerID, joinCards(s.Hole))
+			}
+		}
+	}
+
+	writeStreets := func(to Street) {
+		for street < to && int(street)+1 < len(streetCards) && streetCards[street+1] <= len(h.Board) {
+			street++
+			bets, currentBet = map[int]int{}, 0
+
+			n := streetCards[street]
+			if street == Flop {
+				fmt.Fprintf(&b, "*** %s *** [%s]\n", streetNames[street], joinCards(h.Board[:n]))
+				continue
+			}
+
+			fmt.Fprintf(&b, "*** %s *** [%s] [%s]\n", streetNames[street], joinCards(h.Board[:n-1]), joinCards(h.Board[n-1:n]))
+		}
+	}
+
+	for _, a := range h.Actions {
+		if a.Type < PostAnte {
+			writeHoleCards()
+		}
+
+		writeStreets(a.Street)
+
+		allIn := ""
+		if h.allIn(a) {
+			allIn = " and is all-in"
+		}
+
+		switch a.Type {
+		case PostAnte, PostSmallBlind, PostBigBlind:
+			fmt.Fprintf(&b, "%d: posts %s %d%s\n", a.PlayerID, postNames[a.Type], a.Amount, allIn)
+		case Fold:
+			fmt.Fprintf(&b, "%d: folds\n", a.PlayerID)
+		case Check:
+			fmt.Fprintf(&b, "%d: checks\n", a.PlayerID)
+		case Call:
+			fmt.Fprintf(&b, "%d: calls %d\n", a.PlayerID, a.Amount-bets[a.Seat])
+		case Bet:
+			fmt.Fprintf(&b, "%d: bets %d\n", a.PlayerID, a.Amount)
+		case Raise:
+			fmt.Fprintf(&b, "%d: raises %d to %d\n", a.PlayerID, a.Amount-currentBet, a.Amount)
+		case AllIn:
+			switch {
+			case a.Amount <= currentBet:
+				fmt.Fprintf(&b, "%d: calls %d%s\n", a.PlayerID, a.Amount-bets[a.Seat], allIn)
+			case currentBet == 0:
+				fmt.Fprintf(&b, "%d: bets %d%s\n", a.PlayerID, a.Amount, allIn)
+			default:
+				fmt.Fprintf(&b, "%d: raises %d to %d%s\n", a.PlayerID, a.Amount-currentBet, a.Amount, allIn)
+			}
+		}
+
+		if a.Type != PostAnte {
+			bets[a.Seat] = a.Amount
+			currentBet = max(currentBet, a.Amount)
+		}
+	}
+
+	writeHoleCards()
+	writeStreets(River)
+
+	if len(h.Showdown) > 0 {
+		b.WriteString("*** SHOW DOWN ***\n")
+
+		for _, s := range h.Showdown {
+			fmt.Fprintf(&b, "%d: shows [%s]\n", s.PlayerID, joinCards(s.Cards))
+		}
+	}
+
+	for _, a := range h.Awards {
+		fmt.Fprintf(&b, "%d collected %d from pot\n", a.PlayerID, a.Amount)
+	}
+
+	b.WriteString("*** SUMMARY ***\n")
+	fmt.Fprintf(&b, "Total pot %d\n", h.Pot())
+
+	if len(h.Board) > 0 {
+		fmt.Fprintf(&b, "Board [%s]\n", joinCards(h.Board))
+	}
+
+	for _, a := range h.Awards {
+		fmt.Fprintf(&b, "Seat %d: %d ", a.Seat+1, a.PlayerID)
+
+		if shown := h.shown(a.Seat); shown != nil {
+			fmt.Fprintf(&b, "showed [%s] and won (%d)", joinCards(shown), a.Amount)
+		} else {
+			fmt.Fprintf(&b, "collected (%d)", a.Amount)
+		}
+
+		if len(a.Best) > 0 {
+			fmt.Fprintf(&b, " with [%s]", joinCards(a.Best))
+		}
+
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// allIn return true if action put all chips of player in pot
+func (h *History) allIn(action HistoryAction) bool {
+	if action.Type == AllIn {
+		return true
+	}
+
+	s := h.seat(action.Seat)
+	if action.Type < PostAnte || s == nil {
+		return false
+	}
+
+	spent := action.Amount
+
+	for _, a := range h.Actions {
+		if a.Seat == action.Seat && a.Type == PostAnte && action.Type != PostAnte {
+			spent += a.Amount
+		}
+	}
+
+	return spent >= s.Stack
+}
+
+// shown return cards shown at showdown by seat
+func (h *History) shown(seat int) []int {
+	for _, s := range h.Showdown {
+		if s.Seat == seat {
+			return s.Cards
+		}
+	}
+
+	return nil
+}
+
+// ParseHistory parse hand history written by History.Text
+func ParseHistory(text string) (*History, error) {
+	h := &History{}
+	street := Preflop
+	bets := map[int]int{}
+	seats := map[uint64]int{}
+	summary := false
+
+	invalid := func(line string) error {
+		return fmt.Errorf("%w: %q", ErrInvalidHistory, line)
+	}
+
+	lines := bufio.NewScanner(strings.NewReader(text))
+	for lines.Scan() {
+		line := strings.TrimSpace(lines.Text())
+
+		var m []string
+
+		switch {
+		case line == "" || line == "*** HOLE CARDS ***" || line == "*** SHOW DOWN ***":
+		case line == "*** SUMMARY ***":
+			summary = true
+		case summary:
+			if m = awardLine.FindStringSubmatch(line); m != nil {
+				award := HistoryAward{Seat: atoi(m[1]) - 1, PlayerID: parseID(m[2]), Amount: atoi(m[3])}
+
+				if m[4] != "" {
+					best, err := cardIDs(strings.Fields(m[4]))
+					if err != nil {
+						return nil, err
+					}
+
+					award.Best = best
+				}
+
+				h.Awards = append(h.Awards, award)
+			}
+		case headerLine.MatchString(line):
+			m = headerLine.FindStringSubmatch(line)
+			h.HandID = parseID(m[1])
+			h.SmallBlind, h.BigBlind = atoi(m[3]), atoi(m[4])
+
+			for l, name := range limitNames {
+				if name == m[2] {
+					h.Limit = l
+				}
+			}
+
+			if m[5] != "" {
+				h.Ante = atoi(m[5])
+			}
+
+			t, err := time.Parse(historyTimeLayout, m[6])
+			if err != nil {
+				return nil, invalid(line)
+			}
+
+			h.Time = t
+		case tableLine.MatchString(line):
+			m = tableLine.FindStringSubmatch(line)
+			h.Table, h.MaxSeats, h.Button = m[1], atoi(m[2]), atoi(m[3])-1
+		case seatLine.MatchString(line):
+			m = seatLine.FindStringSubmatch(line)
+			s := HistorySeat{Seat: atoi(m[1]) - 1, PlayerID: parseID(m[2]), Stack: atoi(m[3])}
+			seats[s.PlayerID] = s.Seat
+			h.Seats = append(h.Seats, s)
+		case postLine.MatchString(line):
+			m = postLine.FindStringSubmatch(line)
+			a := HistoryAction{Street: street, PlayerID: parseID(m[1]), Amount: atoi(m[3])}
+			a.Seat = seats[a.PlayerID]
+
+			for t, name := range postNames {
+				if name == m[2] {
+					a.Type = t
+				}
+			}
+
+			if a.Type != PostAnte {
+				bets[a.Seat] = a.Amount
+			}
+
+			h.Actions = append(h.Actions, a)
+		case dealtLine.MatchString(line):
+			m = dealtLine.FindStringSubmatch(line)
+
+			hole, err := cardIDs(strings.Fields(m[2]))
+			if err != nil {
+				return nil, err
+			}
+
+			s := h.seat(seats[parseID(m[1])])
+			if s == nil {
+				return nil, invalid(line)
+			}
+
+			s.Hole = hole
+		case actionLine.MatchString(line):
+			m = actionLine.FindStringSubmatch(line)
+			a := HistoryAction{Street: street, PlayerID: parseID(m[1])}
+			a.Seat = seats[a.PlayerID]
+
+			switch {
+			case m[2] == "folds":
+				a.Type, a.Amount = Fold, bets[a.Seat]
+			case m[2] == "checks":
+				a.Type, a.Amount = Check, bets[a.Seat]
+			case m[3] != "":
+				a.Type, a.Amount = Call, bets[a.Seat]+atoi(m[3])
+			case m[4] != "":
+				a.Type, a.Amount = Bet, atoi(m[4])
+			default:
+				a.Type, a.Amount = Raise, atoi(m[6])
+			}
+
+			if m[7] != "" {
+				a.Type = AllIn
+			}
+
+			bets[a.Seat] = a.Amount
+			h.Actions = append(h.Actions, a)
+		case streetLine.MatchString(line):
+			m = streetLine.FindStringSubmatch(line)
+
+			var names []string
+			for _, group := range cardsGroups.FindAllStringSubmatch(m[2], -1) {
+				names = append(names, strings.Fields(group[1])...)
+			}
+
+			board, err := cardIDs(names)
+			if err != nil {
+				return nil, err
+			}
+
+			for s, name := range streetNames {
+				if name == m[1] {
+					street = s
+				}
+			}
+
+			if len(board) != streetCards[street] {
+				return nil, invalid(line)
+			}
+
+			h.Board = board
+			bets = map[int]int{}
+		case showLine.MatchString(line):
+			m = showLine.FindStringSubmatch(line)
+
+			shown, err := cardIDs(strings.Fields(m[2]))
+			if err != nil {
+				return nil, err
+			}
+
+			id := parseID(m[1])
+			h.Showdown = append(h.Showdown, HistoryShow{Seat: seats[id], PlayerID: id, Cards: shown})
+		case strings.Contains(line, " collected "):
+		default:
+			return nil, invalid(line)
+		}
+	}
+
+	if h.HandID == 0 || len(h.Seats) == 0 {
+		return nil, fmt.Errorf("%w: header or seats not found", ErrInvalidHistory)
+	}
+
+	return h, nil
+}
+
+func joinCards(ids []int) string {
+	return strings.Join(cards.GetCardsNames(ids), " ")
+}
+
+// atoi parse number matched by regular expression
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+func parseID(s string) uint64 {
+	n, _ := strconv.ParseUint(s, 10, 64)
+	return n
+}
@@ -0,0 +1,303 @@
+package holdem
+
+import (
+	"testing"
+
+	"github.com/InsideGallery/game-core/cards"
+	"github.com/InsideGallery/game-core/engine/enginetest"
+
+	"github.com/InsideGallery/core/testutils"
+)
+
+// stackedDeck return deck factory which deal given cards first
+func stackedDeck(names ...string) func() *cards.Deck {
+	return func() *cards.Deck {
+		top := cards.GetCardsIDs(names)
+		d := cards.NewDeckFromCards(top)
+		rest := cards.NewDeck()
+
+		for _, c := range rest.Cards() {
+			found := false
+
+			for _, t := range top {
+				found = found || t == c
+			}
+
+			if !found {
+				d.ReturnBottom(c)
+			}
+		}
+
+		return d
+	}
+}
+
+func newTestTable(t *testing.T, cfg Config, stacks ...int) (*Table, []*enginetest.Player) {
+	table, err := NewTable(cfg)
+	testutils.Equal(t, err, nil)
+
+	players := make([]*enginetest.Player, len(stacks))
+	for i, stack := range stacks {
+		players[i] = enginetest.NewPlayer(uint64(i + 1))
+		testutils.Equal(t, table.Sit(i, players[i], stack), nil)
+	}
+
+	return table, players
+}
+
+func stacks(table *Table) []int {
+	var result []int
+
+	for _, s := range table.Seats() {
+		if s != nil {
+			result = append(result, s.Stack)
+		}
+	}
+
+	return result
+}
+
+func TestHand(t *testing.T) {
+	table, players := newTestTable(t, Config{SmallBlind: 1, BigBlind: 2, MsgType: 9}, 100, 100, 100)
+	table.SetDeckFactory(stackedDeck(
+		"2c", "Ah", "Kh", "3d", "Ad", "Kd", // hole cards from small blind
+		"4s", "7c", "8d", "9s", // burn and flop
+		"4h", "Js", // burn and turn
+		"4d", "2h", // burn and river
+	))
+
+	var events []Event
+
+	table.OnEvent(func(e Event) { events = append(events, e) })
+
+	testutils.Equal(t, table.Sit(1, enginetest.NewPlayer(10), 100), ErrSeatTaken)
+	testutils.Equal(t, table.Sit(5, players[0], 100), ErrAlreadySeated)
+	testutils.Equal(t, table.StartHand(), nil)
+	testutils.Equal(t, table.StartHand(), ErrHandInProgress)
+	testutils.Equal(t, table.Button(), 0)
+	testutils.Equal(t, table.Pot(), 3)
+
+	id, _ := table.ToAct()
+	testutils.Equal(t, id, uint64(1))
+	testutils.Equal(t, table.Act(2, Action{Type: Fold}), ErrNotYourTurn)
+	testutils.Equal(t, table.Act(1, Action{Type: Bet, Amount: 6}), ErrInvalidAction)
+	testutils.Equal(t, table.Act(1, Action{Type: Raise, Amount: 3}), ErrBetTooSmall)
+	testutils.Equal(t, table.Act(1, Action{Type: Check}), ErrInvalidAction)
+	testutils.Equal(t, table.Act(1, Action{Type: Raise, Amount: 6}), nil)
+	testutils.Equal(t, table.Act(2, Action{Type: Fold}), nil)
+
+	o, err := table.Options(3)
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, o, Options{CallAmount: 4, CanRaise: true, MinRaiseTo: 10, MaxRaiseTo: 100})
+	testutils.Equal(t, table.Act(3, Action{Type: Call}), nil)
+
+	testutils.Equal(t, table.Street(), Flop)
+	testutils.Equal(t, table.Board(), cards.GetCardsIDs([]string{"7c", "8d", "9s"}))
+	testutils.Equal(t, table.Act(3, Action{Type: Check}), nil)
+	testutils.Equal(t, table.Act(1, Action{Type: Bet, Amount: 1}), ErrBetTooSmall)
+	testutils.Equal(t, table.Act(1, Action{Type: Bet, Amount: 10}), nil)
+	testutils.Equal(t, table.Act(3, Action{Type: Call}), nil)
+
+	testutils.Equal(t, table.Street(), Turn)
+	testutils.Equal(t, table.Act(3, Action{Type: Check}), nil)
+	testutils.Equal(t, table.Act(1, Action{Type: Check}), nil)
+	testutils.Equal(t, table.Act(3, Action{Type: Check}), nil)
+	testutils.Equal(t, table.Act(1, Action{Type: Check}), nil)
+
+	testutils.Equal(t, table.InHand(), false)
+	testutils.Equal(t, table.Street(), Showdown)
+	testutils.Equal(t, stacks(table), []int{84, 99, 117})
+	testutils.Equal(t, table.LastResult().Winners[0].PlayerID, uint64(3))
+	testutils.Equal(t, events[len(events)-1].Type, EventHandEnded)
+
+	// hole cards of other players are hidden
+	var own, hidden int
+
+	for _, e := range events {
+		if e.Type == EventHoleCards {
+			own += len(e.Cards)
+		}
+	}
+
+	testutils.Equal(t, own, 6)
+
+	for _, m := range players[0].GetQueue() {
+		if m[1] == byte(EventHoleCards) && m[len(m)-1] == 0 {
+			hidden++
+		}
+	}
+
+	testutils.Equal(t, hidden, 2)
+
+	// button and blinds rotate
+	testutils.Equal(t, table.StartHand(), nil)
+	testutils.Equal(t, table.Button(), 1)
+	testutils.Equal(t, stacks(table), []int{84 - 2, 99, 117 - 1})
+}
+
+func TestUncontested(t *testing.T) {
+	table, _ := newTestTable(t, Config{SmallBlind: 5, BigBlind: 10, Ante: 1}, 100, 100)
+	testutils.Equal(t, table.StartHand(), nil)
+	testutils.Equal(t, table.Pot(), 17)
+
+	// heads up button posts small blind and acts first
+	id, _ := table.ToAct()
+	testutils.Equal(t, id, uint64(1))
+	testutils.Equal(t, table.Act(1, Action{Type: Fold}), nil)
+	testutils.Equal(t, stacks(table), []int{94, 106})
+	testutils.Equal(t, table.LastResult() == nil, true)
+}
+
+func TestAllInRunOut(t *testing.T) {
+	table, _ := newTestTable(t, Config{SmallBlind: 1, BigBlind: 2}, 50, 100, 100)
+	table.SetDeckFactory(stackedDeck(
+		"Kh", "Qh", "Ah", "Kd", "Qd", "Ad",
+		"4s", "7c", "8d", "9s", "4h", "Js", "4d", "2h",
+	))
+
+	testutils.Equal(t, table.StartHand(), nil)
+	testutils.Equal(t, table.Act(1, Action{Type: AllIn}), nil)
+	testutils.Equal(t, table.Act(2, Action{Type: AllIn}), nil)
+	testutils.Equal(t, table.Act(3, Action{Type: Call}), nil)
+
+	testutils.Equal(t, table.InHand(), false)
+	testutils.Equal(t, len(table.Board()), 5)
+	// aces win main pot, kings win side pot between kings and queens
+	testutils.Equal(t, stacks(table), []int{150, 100, 0})
+}
+
+func TestLeaveMidHand(t *testing.T) {
+	table, _ := newTestTable(t, Config{SmallBlind: 1, BigBlind: 2}, 100, 100, 100)
+	testutils.Equal(t, table.StartHand(), nil)
+
+	// button raises, small blind calls and leaves after folding on the flop
+	testutils.Equal(t, table.Act(1, Action{Type: Raise, Amount: 6}), nil)
+	testutils.Equal(t, table.Act(2, Action{Type: Call}), nil)
+	testutils.Equal(t, table.Leave(2), ErrHandInProgress)
+	testutils.Equal(t, table.Act(3, Action{Type: Call}), nil)
+	testutils.Equal(t, table.Act(2, Action{Type: Fold}), nil)
+	testutils.Equal(t, table.Leave(2), nil)
+	testutils.Equal(t, table.Pot(), 18)
+
+	testutils.Equal(t, table.Act(3, Action{Type: Bet, Amount: 10}), nil)
+	testutils.Equal(t, table.Act(1, Action{Type: Fold}), nil)
+
+	total := 0
+	for _, stack := range stacks(table) {
+		total += stack
+	}
+
+	testutils.Equal(t, table.InHand(), false)
+	testutils.Equal(t, stacks(table), []int{94, 112})
+	testutils.Equal(t, total+94, 300)
+}
+
+func TestIncompleteRaise(t *testing.T) {
+	table, _ := newTestTable(t, Config{SmallBlind: 1, BigBlind: 2}, 100, 100, 9)
+	testutils.Equal(t, table.StartHand(), nil)
+	testutils.Equal(t, table.Act(1, Action{Type: Raise, Amount: 6}), nil)
+	testutils.Equal(t, table.Act(2, Action{Type: Call}), nil)
+	testutils.Equal(t, table.Act(3, Action{Type: AllIn}), nil)
+
+	o, err := table.Options(1)
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, o.CanRaise, false)
+	testutils.Equal(t, o.CallAmount, 3)
+	testutils.Equal(t, table.Act(1, Action{Type: Raise, Amount: 20}), ErrInvalidAction)
+	testutils.Equal(t, table.Act(1, Action{Type: Call}), nil)
+	testutils.Equal(t, table.Act(2, Action{Type: Call}), nil)
+
+	testutils.Equal(t, table.Street(), Flop)
+	testutils.Equal(t, table.Pot(), 27)
+
+	for table.InHand() {
+		id, _ := table.ToAct()
+		testutils.Equal(t, table.Act(id, Action{Type: Check}), nil)
+	}
+
+	total := 0
+	for _, s := range stacks(table) {
+		total += s
+	}
+
+	testutils.Equal(t, total, 209)
+}
+
+func TestPotLimit(t *testing.T) {
+	table, _ := newTestTable(t, Config{SmallBlind: 1, BigBlind: 2, Limit: PotLimit}, 100, 100)
+	testutils.Equal(t, table.StartHand(), nil)
+
+	o, err := table.Options(1)
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, o.MaxRaiseTo, 6)
+	testutils.Equal(t, table.Act(1, Action{Type: Raise, Amount: 7}), ErrBetTooLarge)
+	testutils.Equal(t, table.Act(1, Action{Type: AllIn}), ErrBetTooLarge)
+	testutils.Equal(t, table.Act(1, Action{Type: Raise, Amount: 6}), nil)
+
+	// pot 8, call 4: raise to 6 + 8 + 4
+	o, err = table.Options(2)
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, o.MinRaiseTo, 10)
+	testutils.Equal(t, o.MaxRaiseTo, 18)
+}
+
+func TestFixedLimit(t *testing.T) {
+	table, _ := newTestTable(t, Config{SmallBlind: 1, BigBlind: 2, Limit: FixedLimit}, 100, 100)
+	testutils.Equal(t, table.StartHand(), nil)
+	testutils.Equal(t, table.Act(1, Action{Type: Raise, Amount: 5}), ErrBetTooLarge)
+	testutils.Equal(t, table.Act(1, Action{Type: Raise, Amount: 4}), nil)
+	testutils.Equal(t, table.Act(2, Action{Type: Raise, Amount: 6}), nil)
+	testutils.Equal(t, table.Act(1, Action{Type: Raise, Amount: 8}), nil)
+
+	o, err := table.Options(2)
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, o.CanRaise, false)
+	testutils.Equal(t, table.Act(2, Action{Type: Raise, Amount: 10}), ErrInvalidAction)
+	testutils.Equal(t, table.Act(2, Action{Type: Call}), nil)
+
+	testutils.Equal(t, table.Act(2, Action{Type: Check}), nil)
+	testutils.Equal(t, table.Act(1, Action{Type: Bet, Amount: 2}), nil)
+	testutils.Equal(t, table.Act(2, Action{Type: Call}), nil)
+
+	// turn bets are doubled
+	testutils.Equal(t, table.Street(), Turn)
+	testutils.Equal(t, table.Act(2, Action{Type: Bet, Amount: 2}), ErrBetTooSmall)
+	testutils.Equal(t, table.Act(2, Action{Type: Bet, Amount: 4}), nil)
+}
+
+func TestConfig(t *testing.T) {
+	_, err := NewTable(Config{SmallBlind: 2, BigBlind: 1})
+	testutils.Equal(t, err, ErrInvalidConfig)
+
+	table, _ := newTestTable(t, Config{SmallBlind: 1, BigBlind: 2}, 100)
+	testutils.Equal(t, table.StartHand(), ErrNotEnoughPlayers)
+	testutils.Equal(t, table.Act(1, Action{Type: Check}), ErrNoHand)
+	testutils.Equal(t, table.Leave(1), nil)
+	testutils.Equal(t, table.Leave(1), ErrNotSeated)
+}
+
+func TestDeckTooSmall(t *testing.T) {
+	_, err := NewTable(Config{SmallBlind: 1, BigBlind: 2, MaxSeats: 23})
+	testutils.Equal(t, err, ErrInvalidConfig)
+
+	_, err = NewTable(Config{SmallBlind: 1, BigBlind: 2, MaxSeats: 22})
+	testutils.Equal(t, err, nil)
+
+	table, _ := newTestTable(t, Config{SmallBlind: 1, BigBlind: 2, Ante: 1}, 100, 100)
+
+	var events []Event
+
+	table.OnEvent(func(e Event) { events = append(events, e) })
+	table.SetDeckFactory(func() *cards.Deck { return cards.NewDeckFromCards(cards.GetCardsIDs([]string{"Ah", "Kh", "Qh"})) })
+
+	testutils.Equal(t, table.StartHand(), ErrNotEnoughCards)
+	testutils.Equal(t, table.InHand(), false)
+	testutils.Equal(t, table.Button(), -1)
+	testutils.Equal(t, table.HandID(), uint64(0))
+	testutils.Equal(t, stacks(table), []int{100, 100})
+	testutils.Equal(t, len(events), 0)
+
+	table.SetDeckFactory(stackedDeck())
+	testutils.Equal(t, table.StartHand(), nil)
+	testutils.Equal(t, table.InHand(), true)
+}
@@ -0,0 +1,800 @@
+package holdem
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/InsideGallery/game-core/cards"
+	"github.com/InsideGallery/game-core/engine"
+)
+
+// Seat describe player at table
+type Seat struct {
+	Index        int
+	Player       engine.Player
+	PlayerID     uint64
+	Stack        int
+	Bet          int // chips put on current street
+	Contribution int // chips put during the hand, antes included
+	Hole         []int
+	InHand       bool
+	Folded       bool
+	AllIn        bool
+
+	acted       bool
+	actedRaises int // count of full raises when player acted last time
+}
+
+// live return true if player is still fighting for the pot
+func (s *Seat) live() bool {
+	return s.InHand && !s.Folded
+}
+
+// canAct return true if player is able to make decisions
+func (s *Seat) canAct() bool {
+	return s.live() && !s.AllIn
+}
+
+// Options describe actions available for player to act
+type Options struct {
+	CanCheck   bool
+	CallAmount int // chips to call, zero if check is possible
+	CanRaise   bool
+	MinRaiseTo int // minimum total street bet of bet or raise
+	MaxRaiseTo int // maximum total street bet of bet or raise
+}
+
+// Table texas hold'em table, events are emitted while table is locked so handlers must not call table
+type Table struct {
+	cfg      Config
+	seats    []*Seat
+	newDeck  func() *cards.Deck
+	handlers []func(Event)
+
+	deck       *cards.Deck
+	board      []int
+	street     Street
+	button     int
+	toAct      int
+	currentBet int
+	minRaise   int
+	raises     int // bets and raises on current street
+	fullRaises int // raises which reopen betting on current street
+	handID     uint64
+	inHand     bool
+	result     *cards.ShowdownResult
+	dead       []cards.Hand // folded hands of players who left during the hand
+
+	mu sync.Mutex
+}
+
+// NewTable return new table, deck is shuffled with cryptographically secure source by default
+func NewTable(cfg Config) (*Table, error) {
+	if cfg.MaxSeats == 0 {
+		cfg.MaxSeats = DefaultMaxSeats
+	}
+
+	if cfg.BigBlind <= 0 || cfg.SmallBlind < 0 || cfg.SmallBlind > cfg.BigBlind || cfg.Ante < 0 || cfg.MaxSeats < 2 ||
+		cfg.MaxSeats*2+boardCards > deckSize {
+		return nil, ErrInvalidConfig
+	}
+
+	return &Table{
+		cfg:    cfg,
+		seats:  make([]*Seat, cfg.MaxSeats),
+		button: -1,
+		toAct:  -1,
+		newDeck: func() *cards.Deck {
+			d := cards.NewSecureDeck()
+			d.Shuffle()
+
+			return d
+		},
+	}, nil
+}
+
+// SetDeckFactory set function which return shuffled deck for every hand
+func (t *Table) SetDeckFactory(f func() *cards.Deck) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.newDeck = f
+}
+
+// OnEvent add handler of all table events, hole cards of all players included
+func (t *Table) OnEvent(f func(Event)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.handlers = append(t.handlers, f)
+}
+
+// Config return table config
+func (t *Table) Config() Config {
+	return t.cfg
+}
+
+// Sit put player at seat with stack, player joins from the next hand
+func (t *Table) Sit(seat int, p engine.Player, stack int) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if seat < 0 || seat >= len(t.seats) {
+		return ErrInvalidSeat
+	}
+
+	if t.seats[seat] != nil {
+		return ErrSeatTaken
+	}
+
+	if t.seatOf(p.GetID()) != nil {
+		return ErrAlreadySeated
+	}
+
+	s := &Seat{
+		Index:    seat,
+		Player:   p,
+		PlayerID: p.GetID(),
+		Stack:    stack,
+	}
+	t.seats[seat] = s
+
+	t.emit(Event{Type: EventPlayerSat, Seat: seat, PlayerID: s.PlayerID, Amount: stack})
+
+	return nil
+}
+
+// Leave remove player from table, player in hand must fold before,
+// chips put during the hand stay in the pot as dead money
+func (t *Table) Leave(playerID uint64) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	s := t.seatOf(playerID)
+	if s == nil {
+		return ErrNotSeated
+	}
+
+	if t.inHand && s.live() {
+		return ErrHandInProgress
+	}
+
+	if t.inHand && s.InHand {
+		t.dead = append(t.dead, cards.Hand{PlayerID: s.PlayerID, Contribution: s.Contribution, Folded: true})
+	}
+
+	t.emit(Event{Type: EventPlayerLeft, Seat: s.Index, PlayerID: playerID, Amount: s.Stack})
+	t.seats[s.Index] = nil
+
+	return nil
+}
+
+// AddChips add chips to player stack between hands
+func (t *Table) AddChips(playerID uint64, amount int) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	s := t.seatOf(playerID)
+	if s == nil {
+		return ErrNotSeated
+	}
+
+	if t.inHand && s.InHand {
+		return ErrHandInProgress
+	}
+
+	s.Stack += amount
+
+	return nil
+}
+
+// Seats return copy of seats, nil for empty seats
+func (t *Table) Seats() []*Seat {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	result := make([]*Seat, len(t.seats))
+
+	for i, s := range t.seats {
+		if s != nil {
+			c := *s
+			c.Hole = slices.Clone(s.Hole)
+			result[i] = &c
+		}
+	}
+
+	return result
+}
+
+// Board return board cards
+func (t *Table) Board() []int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return slices.Clone(t.board)
+}
+
+// Street return current street
+func (t *Table) Street() Street {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.street
+}
+
+// Button return seat of dealer button, -1 before first hand
+func (t *Table) Button() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.button
+}
+
+// Pot return all chips put during the hand
+func (t *Table) Pot() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.pot()
+}
+
+// InHand return true if hand is in progress
+func (t *Table) InHand() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.inHand
+}
+
+// HandID return number of the current or last hand
+func (t *Table) HandID() uint64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.handID
+}
+
+// ToAct return player which should act
+func (t *Table) ToAct() (uint64, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.inHand || t.toAct < 0 {
+		return 0, false
+	}
+
+	return t.seats[t.toAct].PlayerID, true
+}
+
+// LastResult return showdown result of the last hand, nil if hand was won without showdown
+func (t *Table) LastResult() *cards.ShowdownResult {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.result
+}
+
+// Options return actions available for player
+func (t *Table) Options(playerID uint64) (Options, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	s, err := t.actor(playerID)
+	if err != nil {
+		return Options{}, err
+	}
+
+	o := Options{
+		CanCheck:   s.Bet == t.currentBet,
+		CallAmount: min(t.currentBet-s.Bet, s.Stack),
+		CanRaise:   t.canRaise(s),
+	}
+
+	if o.CanRaise {
+		o.MinRaiseTo, o.MaxRaiseTo = t.raiseBounds(s)
+	}
+
+	return o, nil
+}
+
+// StartHand move button, post antes and blinds and deal hole cards
+func (t *Table) StartHand() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.inHand {
+		return ErrHandInProgress
+	}
+
+	players := 0
+
+	for _, s := range t.seats {
+		if s == nil {
+			continue
+		}
+
+		*s = Seat{Index: s.Index, Player: s.Player, PlayerID: s.PlayerID, Stack: s.Stack, InHand: s.Stack > 0}
+		if s.InHand {
+			players++
+		}
+	}
+
+	if players < 2 { //nolint:mnd
+		return ErrNotEnoughPlayers
+	}
+
+	// hole cards drawn before table state changed, so failed deal leave table ready for the next hand
+	deck := t.newDeck()
+	if len(deck.Cards()) < players*2+boardCards {
+		return ErrNotEnoughCards
+	}
+
+	button := t.next(t.button, (*Seat).live)
+	holes := t.inOrder(button)
+	dealt := make([][]int, len(holes))
+
+	for range 2 {
+		for i := range holes {
+			c, err := deck.Draw(1)
+			if err != nil {
+				return err
+			}
+
+			dealt[i] = append(dealt[i], c...)
+		}
+	}
+
+	t.handID++
+	t.inHand = true
+	t.result = nil
+	t.dead = nil
+	t.board = nil
+	t.street = Preflop
+	t.deck = deck
+	t.button = button
+	t.resetStreet()
+
+	t.emit(Event{Type: EventHandStarted, Seat: t.button})
+
+	if t.cfg.Ante > 0 {
+		for _, s := range t.inOrder(t.button) {
+			t.post(s, min(t.cfg.Ante, s.Stack), false)
+			t.emit(Event{Type: EventAction, Seat: s.Index, PlayerID: s.PlayerID, Action: PostAnte, Amount: s.Contribution})
+		}
+	}
+
+	sb := t.next(t.button, (*Seat).live)
+	if players == 2 { //nolint:mnd
+		sb = t.button
+	}
+
+	bb := t.next(sb, (*Seat).live)
+
+	t.postBlind(t.seats[sb], t.cfg.SmallBlind, PostSmallBlind)
+	t.postBlind(t.seats[bb], t.cfg.BigBlind, PostBigBlind)
+	t.currentBet = t.cfg.BigBlind
+	t.raises = 1 // big blind counted as bet
+
+	for i, s := range holes {
+		s.Hole = dealt[i]
+	}
+
+	for _, s := range holes {
+		t.emit(Event{Type: EventHoleCards, Seat: s.Index, PlayerID: s.PlayerID, Cards: slices.Clone(s.Hole)})
+	}
+
+	t.toAct = bb
+
+	return t.advance()
+}
+
+// Act apply action of player
+func (t *Table) Act(playerID uint64, a Action) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	s, err := t.actor(playerID)
+	if err != nil {
+		return err
+	}
+
+	action := a.Type
+
+	switch a.Type {
+	case Fold:
+		s.Folded = true
+	case Check:
+		if s.Bet != t.currentBet {
+			return ErrInvalidAction
+		}
+	case Call:
+		if s.Bet >= t.currentBet {
+			return ErrInvalidAction
+		}
+
+		t.post(s, min(t.currentBet-s.Bet, s.Stack), true)
+	case Bet, Raise:
+		if (a.Type == Bet) != (t.currentBet == 0) {
+			return ErrInvalidAction
+		}
+
+		if err := t.raise(s, a.Amount); err != nil {
+			return err
+		}
+	case AllIn:
+		to := s.Bet + s.Stack
+		if s.Stack == 0 {
+			return ErrInvalidAction
+		}
+
+		if to <= t.currentBet {
+			t.post(s, s.Stack, true)
+			break
+		}
+
+		if err := t.raise(s, to); err != nil {
+			return err
+		}
+	default:
+		return ErrInvalidAction
+	}
+
+	s.acted = true
+	s.actedRaises = t.fullRaises
+
+	if s.AllIn && action != Fold {
+		action = AllIn
+	}
+
+	t.emit(Event{Type: EventAction, Seat: s.Index, PlayerID: s.PlayerID, Action: action, Amount: s.Bet})
+
+	return t.advance()
+}
+
+// actor return seat of player if it is turn of the player
+func (t *Table) actor(playerID uint64) (*Seat, error) {
+	if !t.inHand || t.toAct < 0 {
+		return nil, ErrNoHand
+	}
+
+	s := t.seats[t.toAct]
+	if s.PlayerID != playerID {
+		if t.seatOf(playerID) == nil {
+			return nil, ErrNotSeated
+		}
+
+		return nil, ErrNotYourTurn
+	}
+
+	return s, nil
+}
+
+// canRaise return true if player has chips to raise and betting was reopened since the last action of player
+func (t *Table) canRaise(s *Seat) bool {
+	if s.Stack <= t.currentBet-s.Bet {
+		return false
+	}
+
+	if s.acted && s.actedRaises == t.fullRaises {
+		return false
+	}
+
+	return t.cfg.Limit != FixedLimit || t.raises < fixedLimitCap
+}
+
+// raiseBounds return minimum and maximum total street bet, minimum is limited by stack
+func (t *Table) raiseBounds(s *Seat) (int, int) {
+	allIn := s.Bet + s.Stack
+	minTo := t.currentBet + t.minRaise
+	maxTo := allIn
+
+	switch t.cfg.Limit {
+	case PotLimit:
+		maxTo = min(allIn, t.currentBet+t.pot()+t.currentBet-s.Bet)
+	case FixedLimit:
+		maxTo = min(allIn, minTo)
+	}
+
+	return min(minTo, allIn), maxTo
+}
+
+// raise validate and put bet or raise to given total street bet
+func (t *Table) raise(s *Seat, to int) error {
+	if !t.canRaise(s) {
+		return ErrInvalidAction
+	}
+
+	if to > s.Bet+s.Stack {
+		return ErrNotEnoughChips
+	}
+
+	minTo, maxTo := t.raiseBounds(s)
+
+	switch {
+	case to < minTo:
+		return ErrBetTooSmall
+	case to > maxTo:
+		return ErrBetTooLarge
+	}
+
+	increment := to - t.currentBet
+	t.post(s, to-s.Bet, true)
+
+	if increment >= t.minRaise {
+		if t.cfg.Limit != FixedLimit {
+			t.minRaise = increment
+		}
+
+		t.fullRaises++
+		t.raises++
+	}
+
+	t.currentBet = to
+
+	return nil
+}
+
+// postBlind post blind, short stack is all-in
+func (t *Table) postBlind(s *Seat, amount int, action ActionType) {
+	t.post(s, min(amount, s.Stack), true)
+	t.emit(Event{Type: EventAction, Seat: s.Index, PlayerID: s.PlayerID, Action: action, Amount: s.Bet})
+}
+
+// post move chips from stack to pot, bet counted on the street if live is true
+func (t *Table) post(s *Seat, amount int, live bool) {
+	s.Stack -= amount
+	s.Contribution += amount
+
+	if live {
+		s.Bet += amount
+	}
+
+	if s.Stack == 0 {
+		s.AllIn = true
+	}
+}
+
+// advance pass turn to next player or finish street
+func (t *Table) advance() error {
+	if t.count((*Seat).live) == 1 {
+		t.awardUncontested()
+		return nil
+	}
+
+	if !t.bettingDone() {
+		t.toAct = t.next(t.toAct, func(s *Seat) bool {
+			return s.canAct() && (!s.acted || s.Bet < t.currentBet)
+		})
+		t.emit(Event{Type: EventTurn, Seat: t.toAct, PlayerID: t.seats[t.toAct].PlayerID, Amount: t.currentBet})
+
+		return nil
+	}
+
+	for t.street < River {
+		if err := t.dealStreet(); err != nil {
+			return err
+		}
+
+		if t.count((*Seat).canAct) > 1 {
+			t.toAct = t.next(t.button, (*Seat).canAct)
+			t.emit(Event{Type: EventTurn, Seat: t.toAct, PlayerID: t.seats[t.toAct].PlayerID})
+
+			return nil
+		}
+	}
+
+	t.showdown()
+
+	return nil
+}
+
+// bettingDone return true if all players matched the bet or can not act
+func (t *Table) bettingDone() bool {
+	canAct := 0
+
+	for _, s := range t.seats {
+		if s == nil || !s.canAct() {
+			continue
+		}
+
+		canAct++
+
+		if s.Bet < t.currentBet {
+			return false
+		}
+	}
+
+	if canAct <= 1 {
+		return true
+	}
+
+	for _, s := range t.seats {
+		if s != nil && s.canAct() && !s.acted {
+			return false
+		}
+	}
+
+	return true
+}
+
+// dealStreet burn card and deal next street
+func (t *Table) dealStreet() error {
+	t.street++
+	t.resetStreet()
+
+	n := 1
+	if t.street == Flop {
+		n = 3
+	}
+
+	if _, err := t.deck.Burn(); err != nil {
+		return err
+	}
+
+	c, err := t.deck.Draw(n)
+	if err != nil {
+		return err
+	}
+
+	t.board = append(t.board, c...)
+	t.emit(Event{Type: EventStreet, Street: t.street, Cards: slices.Clone(t.board)})
+
+	return nil
+}
+
+func (t *Table) resetStreet() {
+	t.currentBet = 0
+	t.minRaise = t.cfg.BigBlind
+
+	if t.cfg.Limit == FixedLimit {
+		t.minRaise = t.cfg.betSize(t.street)
+	}
+	t.raises = 0
+	t.fullRaises = 0
+
+	for _, s := range t.seats {
+		if s != nil {
+			s.Bet = 0
+			s.acted = false
+			s.actedRaises = 0
+		}
+	}
+}
+
+// awardUncontested give pot to the last player
+func (t *Table) awardUncontested() {
+	winner := t.seats[t.next(t.button, (*Seat).live)]
+	pot := t.pot()
+	winner.Stack += pot
+
+	t.emit(Event{Type: EventPotAwarded, Seat: winner.Index, PlayerID: winner.PlayerID, Amount: pot})
+	t.endHand()
+}
+
+// showdown show cards, split pots and pay winners
+func (t *Table) showdown() {
+	t.street = Showdown
+
+	hands := slices.Clone(t.dead)
+
+	for _, s := range t.inOrder(t.button) {
+		hands = append(hands, cards.Hand{
+			PlayerID:     s.PlayerID,
+			Cards:        s.Hole,
+			Contribution: s.Contribution,
+			Folded:       s.Folded,
+		})
+
+		if s.live() {
+			t.emit(Event{Type: EventShowdown, Seat: s.Index, PlayerID: s.PlayerID, Cards: slices.Clone(s.Hole)})
+		}
+	}
+
+	t.result = cards.Showdown(t.board, hands)
+
+	for _, a := range t.result.Winners {
+		s := t.seatOf(a.PlayerID)
+		s.Stack += a.Amount
+		t.emit(Event{Type: EventPotAwarded, Seat: s.Index, PlayerID: s.PlayerID, Amount: a.Amount, Cards: a.Best})
+	}
+
+	t.endHand()
+}
+
+func (t *Table) endHand() {
+	t.inHand = false
+	t.toAct = -1
+	t.emit(Event{Type: EventHandEnded})
+}
+
+// pot return all chips put during the hand, dead money included
+func (t *Table) pot() int {
+	pot := 0
+
+	for _, h := range t.dead {
+		pot += h.Contribution
+	}
+
+	for _, s := range t.seats {
+		if s != nil && s.InHand {
+			pot += s.Contribution
+		}
+	}
+
+	return pot
+}
+
+// next return next seat after from which match filter, from itself checked last
+func (t *Table) next(from int, filter func(*Seat) bool) int {
+	for i := 1; i <= len(t.seats); i++ {
+		j := (from + i + len(t.seats)) % len(t.seats)
+		if s := t.seats[j]; s != nil && filter(s) {
+			return j
+		}
+	}
+
+	return -1
+}
+
+// inOrder return seats in hand starting after given seat
+func (t *Table) inOrder(from int) []*Seat {
+	var result []*Seat
+
+	for i := 1; i <= len(t.seats); i++ {
+		if s := t.seats[(from+i)%len(t.seats)]; s != nil && s.InHand {
+			result = append(result, s)
+		}
+	}
+
+	return result
+}
+
+func (t *Table) count(filter func(*Seat) bool) int {
+	n := 0
+
+	for _, s := range t.seats {
+		if s != nil && filter(s) {
+			n++
+		}
+	}
+
+	return n
+}
+
+func (t *Table) seatOf(playerID uint64) *Seat {
+	for _, s := range t.seats {
+		if s != nil && s.PlayerID == playerID {
+			return s
+		}
+	}
+
+	return nil
+}
+
+// emit send event to handlers and players, hole cards sent only to owner
+func (t *Table) emit(e Event) {
+	e.MsgType = t.cfg.MsgType
+	e.HandID = t.handID
+	e.Pot = t.pot()
+
+	for _, h := range t.handlers {
+		h(e)
+	}
+
+	for _, s := range t.seats {
+		if s == nil || s.Player == nil {
+			continue
+		}
+
+		if e.Type == EventHoleCards && s.PlayerID != e.PlayerID {
+			hidden := e
+			hidden.Cards = nil
+			s.Player.AddMessageToQueue(&hidden)
+
+			continue
+		}
+
+		c := e
+		s.Player.AddMessageToQueue(&c)
+	}
+}
@@ -0,0 +1,269 @@
+package cards
+
+import (
+	"math/bits"
+	"sync"
+)
+
+const (
+	ranksCount    = 13
+	maxRankCopies = 4
+	minLookup     = 5
+	maxLookup     = 7
+)
+
+var (
+	// quinaryCount[n][s] count of rank vectors of length n with sum s, each rank used up to 4 times
+	quinaryCount [ranksCount + 1][maxLookup + 1]int
+	// quinaryOffset[q][n][s] count of vectors which precede vectors starting with q
+	quinaryOffset [maxRankCopies + 1][ranksCount][maxLookup + 1]int
+	// noFlushScores[k] score by perfect hash of ranks for k cards
+	noFlushScores [maxLookup + 1][]int
+	// flushScores score of suited cards by rank mask
+	flushScores [1 << ranksCount]int
+
+	lookupOnce sync.Once
+)
+
+// LookupEvaluation evaluate 5 to 7 cards in constant time without allocations using precomputed tables
+// Scores are identical to BinaryEvaluation, other count of cards evaluated by BinaryEvaluation
+type LookupEvaluation struct{}
+
+// NewLookupEvaluation return lookup evaluation, tables built on first call
+func NewLookupEvaluation() LookupEvaluation {
+	lookupOnce.Do(buildLookupTables)
+
+	return LookupEvaluation{}
+}
+
+// Evaluate return score of best combination
+func (l LookupEvaluation) Evaluate(cards []int) int {
+	n := len(cards)
+	if n < minLookup || n > maxLookup {
+		return BinaryEvaluation{}.Evaluate(cards)
+	}
+
+	lookupOnce.Do(buildLookupTables)
+
+	var counts [ranksCount]uint8
+	var suitCounts [4]uint8
+	var suitMasks [4]int
+
+	for _, c := range cards {
+		if c&rank == 0 || c&suit == 0 {
+			return 0
+		}
+
+		r := bits.TrailingZeros(uint(c&rank)) - 4 //nolint:mnd
+		s := bits.TrailingZeros(uint(c & suit))
+
+		counts[r]++
+		if counts[r] > maxRankCopies {
+			return 0
+		}
+
+		suitCounts[s]++
+		suitMasks[s] |= 1 << r
+	}
+
+	for s, count := range suitCounts {
+		if count >= minLookup {
+			return flushScores[suitMasks[s]]
+		}
+	}
+
+	return noFlushScores[n][quinaryHash(&counts, n)]
+}
+
+// quinaryHash return index of rank vector among all vectors with the same sum
+func quinaryHash(counts *[ranksCount]uint8, k int) int {
+	var h int
+
+	for i := 0; i < ranksCount && k > 0; i++ {
+		h += quinaryOffset[counts[i]][ranksCount-i-1][k]
+		k -= int(counts[i])
+	}
+
+	return h
+}
+
+func buildLookupTables() {
+	quinaryCount[0][0] = 1
+
+	for n := 1; n <= ranksCount; n++ {
+		for s := 0; s <= maxLookup; s++ {
+			for d := 0; d <= maxRankCopies && d <= s; d++ {
+				quinaryCount[n][s] += quinaryCount[n-1][s-d]
+			}
+		}
+	}
+
+	for q := 1; q <= maxRankCopies; q++ {
+		for n := 0; n < ranksCount; n++ {
+			for s := 0; s <= maxLookup; s++ {
+				quinaryOffset[q][n][s] = quinaryOffset[q-1][n][s]
+				if s-q+1 >= 0 {
+					quinaryOffset[q][n][s] += quinaryCount[n][s-q+1]
+				}
+			}
+		}
+	}
+
+	for k := minLookup; k <= maxLookup; k++ {
+		noFlushScores[k] = make([]int, quinaryCount[ranksCount][k])
+
+		var counts [ranksCount]uint8
+
+		enumerateRanks(&counts, 0, k, func() {
+			noFlushScores[k][quinaryHash(&counts, k)] = rankCountsScore(&counts)
+		})
+	}
+
+	for mask := range flushScores {
+		if bits.OnesCount(uint(mask)) >= minLookup {
+			flushScores[mask] = suitedScore(mask)
+		}
+	}
+}
+
+// enumerateRanks call f for every rank vector with given sum
+func enumerateRanks(counts *[ranksCount]uint8, i, left int, f func()) {
+	if i == ranksCount {
+		if left == 0 {
+			f()
+		}
+
+		return
+	}
+
+	for d := 0; d <= maxRankCopies && d <= left; d++ {
+		counts[i] = uint8(d) //nolint:gosec
+		enumerateRanks(counts, i+1, left-d, f)
+	}
+
+	counts[i] = 0
+}
+
+// packRanks pack rank indexes the same way as Combination.Score
+func packRanks(category int, ranks ...int) int {
+	score := 0
+	for _, r := range ranks {
+		score = score<<rankBits | (r + 1)
+	}
+
+	for i := len(ranks); i < 5; i++ { //nolint:mnd
+		score <<= rankBits
+	}
+
+	return category<<scoreShift | score
+}
+
+// maskStraightTop return rank index of the highest straight in rank mask, -1 if there is no straight
+func maskStraightTop(mask int) int {
+	for top := ranksCount - 1; top >= 4; top-- { //nolint:mnd
+		m := 0x1f << (top - 4) //nolint:mnd
+		if mask&m == m {
+			return top
+		}
+	}
+
+	wheel := 1<<(ranksCount-1) | 0xf //nolint:mnd
+	if mask&wheel == wheel {
+		return 3 //nolint:mnd
+	}
+
+	return -1
+}
+
+// suitedScore return score of suited cards given by rank mask
+func suitedScore(mask int) int {
+	if top := maskStraightTop(mask); top >= 0 {
+		if top == ranksCount-1 {
+			return royalFlush<<scoreShift | (top + 1)
+		}
+
+		return straightFlush<<scoreShift | (top + 1)
+	}
+
+	ranks := make([]int, 0, 5) //nolint:mnd
+
+	for r := ranksCount - 1; r >= 0 && len(ranks) < 5; r-- { //nolint:mnd
+		if mask&(1<<r) != 0 {
+			ranks = append(ranks, r)
+		}
+	}
+
+	return packRanks(flush, ranks...)
+}
+
+// rankCountsScore return score of not suited cards by counts of ranks
+func rankCountsScore(counts *[ranksCount]uint8) int {
+	var groups [maxRankCopies + 1][]int
+
+	mask := 0
+
+	for r := ranksCount - 1; r >= 0; r-- {
+		if counts[r] != 0 {
+			groups[counts[r]] = append(groups[counts[r]], r)
+			mask |= 1 << r
+		}
+	}
+
+	// kickers return the highest ranks except given ones
+	kickers := func(n int, except ...int) []int {
+		var res []int
+
+		for r := ranksCount - 1; r >= 0 && len(res) < n; r-- {
+			if counts[r] == 0 || containsInt(except, r) {
+				continue
+			}
+
+			res = append(res, r)
+		}
+
+		return res
+	}
+
+	quads, trips, pairs := groups[4], groups[3], groups[2]
+
+	switch {
+	case len(quads) != 0:
+		return packRanks(fourOfAKind, append([]int{quads[0]}, kickers(1, quads[0])...)...)
+	case len(trips) != 0 && len(trips)+len(pairs) > 1:
+		pair := -1
+		if len(trips) > 1 {
+			pair = trips[1]
+		}
+
+		if len(pairs) != 0 && pairs[0] > pair {
+			pair = pairs[0]
+		}
+
+		return packRanks(fullHouse, trips[0], pair)
+	}
+
+	if top := maskStraightTop(mask); top >= 0 {
+		return straight<<scoreShift | (top + 1)
+	}
+
+	switch {
+	case len(trips) != 0:
+		return packRanks(threeOfAKind, append([]int{trips[0]}, kickers(2, trips[0])...)...) //nolint:mnd
+	case len(pairs) > 1:
+		return packRanks(twoPair, append([]int{pairs[0], pairs[1]}, kickers(1, pairs[0], pairs[1])...)...)
+	case len(pairs) == 1:
+		return packRanks(onePair, append([]int{pairs[0]}, kickers(3, pairs[0])...)...) //nolint:mnd
+	default:
+		return packRanks(highCard, kickers(5)...) //nolint:mnd
+	}
+}
+
+func containsInt(values []int, v int) bool {
+	for _, e := range values {
+		if e == v {
+			return true
+		}
+	}
+
+	return false
+}
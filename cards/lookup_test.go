@@ -0,0 +1,129 @@
+package cards
+
+import (
+	"math/bits"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/InsideGallery/core/testutils"
+)
+
+var suitsOrder = []int{suitH, suitC, suitD, suitS}
+
+func rankCard(r int) int {
+	return card2 << r
+}
+
+func TestLookupAllRanks(t *testing.T) {
+	l := NewLookupEvaluation()
+	b := BinaryEvaluation{}
+
+	for k := minLookup; k <= maxLookup; k++ {
+		var counts [ranksCount]uint8
+
+		checked := 0
+		enumerateRanks(&counts, 0, k, func() {
+			var hand []int
+
+			s := 0
+
+			for r, n := range counts {
+				for i := 0; i < int(n); i++ {
+					hand = append(hand, rankCard(r)|suitsOrder[s%len(suitsOrder)])
+					s++
+				}
+			}
+
+			checked++
+
+			if l.Evaluate(hand) != b.Evaluate(hand) {
+				t.Fatalf("score mismatch %v: lookup %d binary %d", GetCardsNames(hand), l.Evaluate(hand), b.Evaluate(hand))
+			}
+		})
+		testutils.Equal(t, checked, len(noFlushScores[k]))
+	}
+}
+
+func TestLookupAllFlushes(t *testing.T) {
+	l := NewLookupEvaluation()
+	b := BinaryEvaluation{}
+
+	for mask := 0; mask < 1<<ranksCount; mask++ {
+		n := bits.OnesCount(uint(mask))
+		if n < minLookup || n > maxLookup {
+			continue
+		}
+
+		var hand []int
+
+		for r := 0; r < ranksCount; r++ {
+			if mask&(1<<r) != 0 {
+				hand = append(hand, rankCard(r)|suitS)
+			}
+		}
+
+		if l.Evaluate(hand) != b.Evaluate(hand) {
+			t.Fatalf("score mismatch %v: lookup %d binary %d", GetCardsNames(hand), l.Evaluate(hand), b.Evaluate(hand))
+		}
+	}
+}
+
+func TestLookupRandomHands(t *testing.T) {
+	l := NewLookupEvaluation()
+	b := BinaryEvaluation{}
+	r := rand.New(rand.NewPCG(1, 2)) //nolint:gosec
+
+	for i := 0; i < 100000; i++ {
+		d := make([]int, len(deck))
+		copy(d, deck)
+		r.Shuffle(len(d), func(i, j int) {
+			d[i], d[j] = d[j], d[i]
+		})
+
+		hand := d[:minLookup+i%3]
+		if l.Evaluate(hand) != b.Evaluate(hand) {
+			t.Fatalf("score mismatch %v: lookup %d binary %d", GetCardsNames(hand), l.Evaluate(hand), b.Evaluate(hand))
+		}
+	}
+}
+
+func TestLookupFallback(t *testing.T) {
+	l := NewLookupEvaluation()
+	hand := GetCardsIDs([]string{"Ah", "Kh", "Qh", "Jh", "Th", "9h", "2c", "3d"})
+	testutils.Equal(t, l.Evaluate(hand), BinaryEvaluation{}.Evaluate(hand))
+	testutils.Equal(t, l.Evaluate([]int{0, 0, 0, 0, 0}), 0)
+}
+
+func TestLookupZeroAllocations(t *testing.T) {
+	l := NewLookupEvaluation()
+	hand := GetCardsIDs([]string{"Ah", "Kd", "Qh", "Jc", "Th", "9h", "2h"})
+
+	var ev Evaluator = l
+
+	allocs := testing.AllocsPerRun(100, func() {
+		ev.Evaluate(hand)
+	})
+	testutils.Equal(t, allocs, float64(0))
+}
+
+func BenchmarkLookupEvaluation(b *testing.B) {
+	l := NewLookupEvaluation()
+	hand := GetCardsIDs([]string{"Ah", "Kd", "Qh", "Jc", "Th", "9h", "2h"})
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		l.Evaluate(hand)
+	}
+}
+
+func BenchmarkBinaryEvaluation(b *testing.B) {
+	e := BinaryEvaluation{}
+	hand := GetCardsIDs([]string{"Ah", "Kd", "Qh", "Jc", "Th", "9h", "2h"})
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		e.Evaluate(hand)
+	}
+}
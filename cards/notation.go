@@ -0,0 +1,215 @@
+package cards
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Format describe output of card names
+type Format uint8
+
+// Card name formats
+const (
+	FormatShort   Format = iota // Ah
+	FormatLong                  // Ace of Hearts
+	FormatUnicode               // A♥
+)
+
+var (
+	rankNames = map[Rank]string{
+		Two: "Two", Three: "Three", Four: "Four", Five: "Five", Six: "Six", Seven: "Seven", Eight: "Eight",
+		Nine: "Nine", Ten: "Ten", Jack: "Jack", Queen: "Queen", King: "King", Ace: "Ace",
+	}
+	suitNames   = map[Suit]string{Hearts: "Hearts", Clubs: "Clubs", Diamonds: "Diamonds", Spades: "Spades"}
+	suitSymbols = map[Suit]string{Hearts: "♥", Clubs: "♣", Diamonds: "♦", Spades: "♠"}
+	// suitRunes suits by letters and symbols, white symbols accepted as well
+	suitRunes = map[rune]Suit{
+		'h': Hearts, 'c': Clubs, 'd': Diamonds, 's': Spades,
+		'♥': Hearts, '♣': Clubs, '♦': Diamonds, '♠': Spades,
+		'♡': Hearts, '♧': Clubs, '♢': Diamonds, '♤': Spades,
+	}
+)
+
+// CardError describe card which can not be parsed, it wraps ErrInvalidCard or ErrDuplicateCard
+type CardError struct {
+	Input    string // text of card
+	Position int    // byte offset of card in parsed text, for ParseCardNames offset inside the name
+	Index    int    // index of name in list parsed by ParseCardNames, -1 for text
+	Err      error
+}
+
+// Error return error message
+func (e *CardError) Error() string {
+	if e.Index >= 0 {
+		return fmt.Sprintf("%s: %q at index %d position %d", e.Err, e.Input, e.Index, e.Position)
+	}
+
+	return fmt.Sprintf("%s: %q at position %d", e.Err, e.Input, e.Position)
+}
+
+// Unwrap return ErrInvalidCard or ErrDuplicateCard
+func (e *CardError) Unwrap() error {
+	return e.Err
+}
+
+// ParseCard parse one card like "Ah", "10h", "a♥"
+func ParseCard(s string) (int, error) {
+	ids, err := ParseCards(s)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(ids) != 1 {
+		return 0, &CardError{Input: s, Index: -1, Err: ErrInvalidCard}
+	}
+
+	return ids[0], nil
+}
+
+// ParseCards parse cards written together or separated by spaces or commas: "AhKd", "Ah Kd", "10h", "A♥ K♦"
+// Ranks and suit letters are case insensitive, duplicated card is an error
+func ParseCards(s string) ([]int, error) {
+	var result []int
+
+	seen := map[int]bool{}
+
+	for pos := 0; pos < len(s); {
+		if s[pos] == ' ' || s[pos] == ',' || s[pos] == '\t' {
+			pos++
+			continue
+		}
+
+		id, size := parseCardAt(s[pos:])
+		if id == 0 {
+			end := min(pos+max(size, 1), len(s))
+			return nil, &CardError{Input: s[pos:end], Position: pos, Index: -1, Err: ErrInvalidCard}
+		}
+
+		if seen[id] {
+			return nil, &CardError{Input: s[pos : pos+size], Position: pos, Index: -1, Err: ErrDuplicateCard}
+		}
+
+		seen[id] = true
+		result = append(result, id)
+		pos += size
+	}
+
+	return result, nil
+}
+
+// ParseCardNames parse list of card names, unlike GetCardsIDs unknown and duplicated names are errors
+// CardError has index of the name in list
+func ParseCardNames(names []string) ([]int, error) {
+	result := make([]int, len(names))
+	seen := map[int]bool{}
+
+	for i, name := range names {
+		id, err := ParseCard(name)
+		if err != nil {
+			var cardErr *CardError
+			if errors.As(err, &cardErr) {
+				cardErr.Index = i
+			}
+
+			return nil, err
+		}
+
+		if seen[id] {
+			return nil, &CardError{Input: name, Index: i, Err: ErrDuplicateCard}
+		}
+
+		seen[id] = true
+		result[i] = id
+	}
+
+	return result, nil
+}
+
+// MustParseCards parse cards and panic on error
+func MustParseCards(s string) []int {
+	ids, err := ParseCards(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return ids
+}
+
+// FormatCard return name of card in given format, empty string for invalid card
+func FormatCard(id int, f Format) string {
+	c, err := CardFromInt(id)
+	if err != nil {
+		return ""
+	}
+
+	return c.Format(f)
+}
+
+// FormatCards return names of cards separated by space, long names separated by comma
+func FormatCards(ids []int, f Format) string {
+	names := make([]string, len(ids))
+	for i, id := range ids {
+		names[i] = FormatCard(id, f)
+	}
+
+	if f == FormatLong {
+		return strings.Join(names, ", ")
+	}
+
+	return strings.Join(names, " ")
+}
+
+// Format return name of card in given format, custom cards and jokers use String
+func (c Card) Format(f Format) string {
+	if !c.IsFrench() {
+		return c.String()
+	}
+
+	switch f {
+	case FormatLong:
+		return rankNames[c.Rank] + " of " + suitNames[c.Suit]
+	case FormatUnicode:
+		return rankLetters[c.Rank] + suitSymbols[c.Suit]
+	}
+
+	return c.String()
+}
+
+// parseCardAt parse card at the start of text, return zero id if card is invalid and size of parsed text
+func parseCardAt(s string) (int, int) {
+	var (
+		r    Rank
+		size int
+	)
+
+	switch {
+	case strings.HasPrefix(s, "10"):
+		r, size = Ten, 2 //nolint:mnd
+	case s != "":
+		for rank, letter := range rankLetters {
+			if strings.EqualFold(s[:1], letter) {
+				r = rank
+			}
+		}
+
+		size = 1
+	}
+
+	if r == RankNone || size >= len(s) {
+		return 0, size
+	}
+
+	sr, n := utf8.DecodeRuneInString(s[size:])
+
+	st, exists := suitRunes[unicode.ToLower(sr)]
+	if !exists {
+		return 0, size + n
+	}
+
+	id, _ := NewCard(r, st).Int()
+
+	return id, size + n
+}
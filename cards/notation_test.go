@@ -0,0 +1,98 @@
+package cards
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/InsideGallery/core/testutils"
+)
+
+func TestParseCards(t *testing.T) {
+	expected := ids("Ah", "Kd")
+
+	for _, s := range []string{"AhKd", "Ah Kd", "ah, kd", "AH KD", "A♥K♦", "A♡ K♢"} {
+		result, err := ParseCards(s)
+		testutils.Equal(t, err, nil)
+		testutils.Equal(t, result, expected)
+	}
+
+	result, err := ParseCards("10h Ts9♠")
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, result, ids("Th", "Ts", "9s"))
+
+	id, err := ParseCard("10c")
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, id, GetCardID("Tc"))
+
+	_, err = ParseCard("AhKd")
+	testutils.Equal(t, errors.Is(err, ErrInvalidCard), true)
+
+	result, err = ParseCardNames([]string{"Ah", "10d"})
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, result, ids("Ah", "Td"))
+}
+
+func TestParseCardsErrors(t *testing.T) {
+	testcases := []struct {
+		input    string
+		err      error
+		card     string
+		position int
+	}{
+		{input: "AhKx", err: ErrInvalidCard, card: "Kx", position: 2},
+		{input: "Ah 1h", err: ErrInvalidCard, card: "1", position: 3},
+		{input: "Ah", err: nil},
+		{input: "AhA", err: ErrInvalidCard, card: "A", position: 2},
+		{input: "Ah Kd ah", err: ErrDuplicateCard, card: "ah", position: 6},
+	}
+
+	for _, tc := range testcases {
+		_, err := ParseCards(tc.input)
+		testutils.Equal(t, errors.Is(err, tc.err), true)
+
+		if tc.err == nil {
+			continue
+		}
+
+		var cardErr *CardError
+
+		testutils.Equal(t, errors.As(err, &cardErr), true)
+		testutils.Equal(t, cardErr.Input, tc.card)
+		testutils.Equal(t, cardErr.Position, tc.position)
+		testutils.Equal(t, cardErr.Index, -1)
+	}
+
+	names := []struct {
+		names    []string
+		err      error
+		card     string
+		index    int
+		position int
+	}{
+		{names: []string{"Ah", "Kd", "Ah"}, err: ErrDuplicateCard, card: "Ah", index: 2},
+		{names: []string{"Ah", "Kd", "Qx"}, err: ErrInvalidCard, card: "Qx", index: 2},
+		{names: []string{"Ah", "Kd Qd"}, err: ErrInvalidCard, card: "Kd Qd", index: 1},
+		{names: []string{"Ah", " 1h"}, err: ErrInvalidCard, card: "1", index: 1, position: 1},
+	}
+
+	for _, tc := range names {
+		_, err := ParseCardNames(tc.names)
+		testutils.Equal(t, errors.Is(err, tc.err), true)
+
+		var cardErr *CardError
+
+		testutils.Equal(t, errors.As(err, &cardErr), true)
+		testutils.Equal(t, cardErr.Input, tc.card)
+		testutils.Equal(t, cardErr.Index, tc.index)
+		testutils.Equal(t, cardErr.Position, tc.position)
+	}
+}
+
+func TestFormatCards(t *testing.T) {
+	hand := ids("Ah", "Td", "2c")
+	testutils.Equal(t, FormatCards(hand, FormatShort), "Ah Td 2c")
+	testutils.Equal(t, FormatCards(hand, FormatLong), "Ace of Hearts, Ten of Diamonds, Two of Clubs")
+	testutils.Equal(t, FormatCards(hand, FormatUnicode), "A♥ T♦ 2♣")
+	testutils.Equal(t, FormatCard(0, FormatLong), "")
+	testutils.Equal(t, NewJoker(SuitNone).Format(FormatLong), "Joker")
+}
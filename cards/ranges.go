@@ -0,0 +1,438 @@
+package cards
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"runtime"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	rankChars       = "23456789TJQKA"
+	suitChars       = "hcds"
+	maxComboRetries = 1000
+)
+
+var (
+	ErrInvalidRange   = errors.New("invalid range")
+	ErrRangesConflict = errors.New("ranges can not be dealt together")
+)
+
+// Combo describe two hole cards with weight from 0 to 1
+type Combo struct {
+	Cards  [2]int
+	Weight float64
+}
+
+// Range list of weighted combos
+type Range []Combo
+
+// ParseRange parse range notation: "AKs, TT+, 76s-54s, AQo+, AhKd, KQ:0.5"
+// Weight given after colon, duplicated combos take the last weight
+func ParseRange(notation string) (Range, error) {
+	var r Range
+
+	index := map[[2]int]int{}
+
+	for _, token := range strings.FieldsFunc(notation, func(c rune) bool { return c == ',' || c == ' ' }) {
+		hand, weight, err := parseWeight(token)
+		if err != nil {
+			return nil, err
+		}
+
+		combos, err := expandHand(hand)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, c := range combos {
+			if i, exists := index[c]; exists {
+				r[i].Weight = weight
+				continue
+			}
+
+			index[c] = len(r)
+			r = append(r, Combo{Cards: c, Weight: weight})
+		}
+	}
+
+	return r, nil
+}
+
+// MustParseRange parse range and panic on error
+func MustParseRange(notation string) Range {
+	r, err := ParseRange(notation)
+	if err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
+// Remove return range without combos blocked by given cards
+func (r Range) Remove(cards ...int) Range {
+	result := make(Range, 0, len(r))
+
+	for _, c := range r {
+		if !slices.Contains(cards, c.Cards[0]) && !slices.Contains(cards, c.Cards[1]) {
+			result = append(result, c)
+		}
+	}
+
+	return result
+}
+
+// Weight return sum of weights of combos
+func (r Range) Weight() float64 {
+	var w float64
+	for _, c := range r {
+		w += c.Weight
+	}
+
+	return w
+}
+
+// RangeEquityRequest describe ranges of players and settings of Monte Carlo calculation
+type RangeEquityRequest struct {
+	Ranges     []Range
+	Board      []int
+	Dead       []int
+	Evaluator  Evaluator // LookupEvaluation by default
+	Iterations int       // DefaultIterations if zero
+	Seed       uint64
+	Workers    int // GOMAXPROCS if zero
+}
+
+// CalculateRangeEquity calculate equity of ranges by sampling weighted combos and boards
+// Result is deterministic for the same seed and count of workers
+func CalculateRangeEquity(req RangeEquityRequest) (*EquityResult, error) {
+	if len(req.Ranges) < 2 { //nolint:mnd
+		return nil, ErrNotEnoughPlayers
+	}
+
+	stock, err := knownStock(req.Board, req.Dead)
+	if err != nil {
+		return nil, err
+	}
+
+	blocked := append(slices.Clone(req.Board), req.Dead...)
+	weights := make([][]float64, len(req.Ranges))
+	ranges := make([]Range, len(req.Ranges))
+
+	for i, r := range req.Ranges {
+		ranges[i] = r.Remove(blocked...)
+		if ranges[i].Weight() <= 0 {
+			return nil, fmt.Errorf("%w: player %d", ErrInvalidRange, i)
+		}
+
+		weights[i] = cumulativeWeights(ranges[i])
+	}
+
+	if req.Evaluator == nil {
+		req.Evaluator = NewLookupEvaluation()
+	}
+
+	if req.Iterations <= 0 {
+		req.Iterations = DefaultIterations
+	}
+
+	if req.Workers <= 0 {
+		req.Workers = runtime.GOMAXPROCS(0)
+	}
+
+	req.Workers = min(req.Workers, req.Iterations)
+	tallies := make([]*tally, req.Workers)
+	errs := make([]error, req.Workers)
+
+	var wg sync.WaitGroup
+
+	for w := range tallies {
+		tallies[w] = newTally(len(ranges))
+
+		wg.Add(1)
+
+		go func(w int) {
+			defer wg.Done()
+
+			errs[w] = sampleRanges(req, ranges, weights, stock, w, tallies[w])
+		}(w)
+	}
+
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	total := newTally(len(ranges))
+	for _, t := range tallies {
+		total.merge(t)
+	}
+
+	result := &EquityResult{
+		Players: make([]PlayerEquity, len(ranges)),
+		Samples: total.total,
+	}
+
+	for i := range result.Players {
+		result.Players[i] = PlayerEquity{
+			Win:    percent(float64(total.win[i]), total.total),
+			Tie:    percent(float64(total.tie[i]), total.total),
+			Lose:   percent(float64(total.lose[i]), total.total),
+			Equity: percent(total.equity[i], total.total),
+		}
+	}
+
+	return result, nil
+}
+
+// sampleRanges evaluate share of samples, combos which conflict with each other are sampled again
+func sampleRanges(req RangeEquityRequest, ranges []Range, weights [][]float64, stock []int, w int, t *tally) error {
+	r := rand.New(rand.NewPCG(req.Seed, uint64(w))) //nolint:gosec
+
+	hands := make([][]int, len(ranges))
+	for i := range hands {
+		hands[i] = make([]int, 2) //nolint:mnd
+	}
+
+	s := newShowdown(req.Evaluator, hands, req.Board)
+	used := make(map[int]bool, len(ranges)*2) //nolint:mnd
+	cards := make([]int, 0, len(stock))
+
+	n := req.Iterations / req.Workers
+	if w < req.Iterations%req.Workers {
+		n++
+	}
+
+	for range n {
+		dealt := false
+
+		for try := 0; try < maxComboRetries && !dealt; try++ {
+			clear(used)
+
+			dealt = true
+
+			for i, rg := range ranges {
+				c := rg[pickWeighted(weights[i], r)]
+				if used[c.Cards[0]] || used[c.Cards[1]] {
+					dealt = false
+					break
+				}
+
+				used[c.Cards[0]], used[c.Cards[1]] = true, true
+				copy(hands[i], c.Cards[:])
+				copy(s.cards[i], c.Cards[:])
+			}
+		}
+
+		if !dealt {
+			return ErrRangesConflict
+		}
+
+		cards = cards[:0]
+		for _, c := range stock {
+			if !used[c] {
+				cards = append(cards, c)
+			}
+		}
+
+		for pos := len(req.Board); pos < boardSize; pos++ {
+			k := pos - len(req.Board)
+			j := k + r.IntN(len(cards)-k)
+			cards[k], cards[j] = cards[j], cards[k]
+			s.board[pos] = cards[k]
+		}
+
+		s.count(t)
+	}
+
+	return nil
+}
+
+func cumulativeWeights(r Range) []float64 {
+	result := make([]float64, len(r))
+
+	var sum float64
+	for i, c := range r {
+		sum += c.Weight
+		result[i] = sum
+	}
+
+	return result
+}
+
+// pickWeighted return index of combo chosen with probability proportional to weight
+func pickWeighted(cumulative []float64, r *rand.Rand) int {
+	v := r.Float64() * cumulative[len(cumulative)-1]
+
+	return min(sort.SearchFloat64s(cumulative, v+1e-12), len(cumulative)-1) //nolint:mnd
+}
+
+// parseWeight split token to hand and weight
+func parseWeight(token string) (string, float64, error) {
+	hand, w, found := strings.Cut(token, ":")
+	if !found {
+		return hand, 1, nil
+	}
+
+	weight, err := strconv.ParseFloat(w, 64)
+	if err != nil || weight < 0 || weight > 1 {
+		return "", 0, fmt.Errorf("%w: weight of %q", ErrInvalidRange, token)
+	}
+
+	return hand, weight, nil
+}
+
+// handSpec describe hand class like "AKs"
+type handSpec struct {
+	hi, lo int  // rank indexes, hi >= lo
+	kind   byte // 's' suited, 'o' offsuit, 0 both
+}
+
+func parseSpec(s string) (handSpec, bool) {
+	if len(s) < 2 || len(s) > 3 {
+		return handSpec{}, false
+	}
+
+	hi, lo := strings.IndexByte(rankChars, s[0]), strings.IndexByte(rankChars, s[1])
+	if hi < 0 || lo < 0 {
+		return handSpec{}, false
+	}
+
+	if hi < lo {
+		hi, lo = lo, hi
+	}
+
+	spec := handSpec{hi: hi, lo: lo}
+
+	if len(s) == 3 { //nolint:mnd
+		if (s[2] != 's' && s[2] != 'o') || hi == lo {
+			return handSpec{}, false
+		}
+
+		spec.kind = s[2]
+	}
+
+	return spec, true
+}
+
+// expandHand return combos of hand, hand class, open range or closed range
+func expandHand(hand string) ([][2]int, error) {
+	invalid := fmt.Errorf("%w: %q", ErrInvalidRange, hand)
+
+	if c, ok := parseExact(hand); ok {
+		return [][2]int{c}, nil
+	}
+
+	var specs []handSpec
+
+	switch from, to, found := strings.Cut(hand, "-"); {
+	case found:
+		a, okA := parseSpec(from)
+		b, okB := parseSpec(to)
+
+		if !okA || !okB || a.kind != b.kind || (a.hi == a.lo) != (b.hi == b.lo) {
+			return nil, invalid
+		}
+
+		if a.hi < b.hi || (a.hi == b.hi && a.lo < b.lo) {
+			a, b = b, a
+		}
+
+		switch {
+		case a.hi == a.lo:
+			for r := b.hi; r <= a.hi; r++ {
+				specs = append(specs, handSpec{hi: r, lo: r})
+			}
+		case a.hi == b.hi:
+			for lo := b.lo; lo <= a.lo; lo++ {
+				specs = append(specs, handSpec{hi: a.hi, lo: lo, kind: a.kind})
+			}
+		case a.hi-a.lo == b.hi-b.lo:
+			for step := 0; step <= a.hi-b.hi; step++ {
+				specs = append(specs, handSpec{hi: b.hi + step, lo: b.lo + step, kind: a.kind})
+			}
+		default:
+			return nil, invalid
+		}
+	case strings.HasSuffix(hand, "+"):
+		spec, ok := parseSpec(strings.TrimSuffix(hand, "+"))
+		if !ok {
+			return nil, invalid
+		}
+
+		if spec.hi == spec.lo {
+			for r := spec.hi; r < len(rankChars); r++ {
+				specs = append(specs, handSpec{hi: r, lo: r})
+			}
+		} else {
+			for lo := spec.lo; lo < spec.hi; lo++ {
+				specs = append(specs, handSpec{hi: spec.hi, lo: lo, kind: spec.kind})
+			}
+		}
+	default:
+		spec, ok := parseSpec(hand)
+		if !ok {
+			return nil, invalid
+		}
+
+		specs = append(specs, spec)
+	}
+
+	var combos [][2]int
+	for _, spec := range specs {
+		combos = append(combos, spec.combos()...)
+	}
+
+	return combos, nil
+}
+
+// combos return concrete combos of hand class in canonical order
+func (h handSpec) combos() [][2]int {
+	var result [][2]int
+
+	for i := range suitChars {
+		for j := range suitChars {
+			switch {
+			case h.hi == h.lo && j <= i:
+				continue
+			case h.hi != h.lo && h.kind == 's' && i != j:
+				continue
+			case h.hi != h.lo && h.kind == 'o' && i == j:
+				continue
+			}
+
+			result = append(result, combo(card2<<h.hi|suitH<<i, card2<<h.lo|suitH<<j))
+		}
+	}
+
+	return result
+}
+
+// parseExact parse exact combo like "AhKd"
+func parseExact(hand string) ([2]int, bool) {
+	if len(hand) != 4 { //nolint:mnd
+		return [2]int{}, false
+	}
+
+	a, b := GetCardID(hand[:2]), GetCardID(hand[2:])
+	if a == 0 || b == 0 || a == b {
+		return [2]int{}, false
+	}
+
+	return combo(a, b), true
+}
+
+// combo return combo of two cards in canonical order, higher card id first
+func combo(a, b int) [2]int {
+	if a < b {
+		a, b = b, a
+	}
+
+	return [2]int{a, b}
+}
@@ -0,0 +1,94 @@
+package cards
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/InsideGallery/core/testutils"
+)
+
+func TestParseRange(t *testing.T) {
+	testcases := []struct {
+		notation string
+		combos   int
+	}{
+		{notation: "AA", combos: 6},
+		{notation: "TT+", combos: 30},
+		{notation: "TT-77", combos: 24},
+		{notation: "AKs", combos: 4},
+		{notation: "AKo", combos: 12},
+		{notation: "AK", combos: 16},
+		{notation: "AQo+", combos: 24},
+		{notation: "A2s+", combos: 48},
+		{notation: "76s-54s", combos: 12},
+		{notation: "K9s-K6s", combos: 16},
+		{notation: "AhKd", combos: 1},
+		{notation: "AKs, TT+, 76s-54s, AQo+", combos: 4 + 30 + 12 + 24},
+		{notation: "AK, AKs:0.5", combos: 16},
+		{notation: "AA, AhAd:0.5", combos: 6},
+		{notation: "KdKh, KK", combos: 6},
+	}
+
+	for _, tc := range testcases {
+		r, err := ParseRange(tc.notation)
+		testutils.Equal(t, err, nil)
+		testutils.Equal(t, len(r), tc.combos)
+	}
+
+	r := MustParseRange("AK, AKs:0.5")
+	testutils.Equal(t, r.Weight(), 12+4*0.5)
+
+	r = MustParseRange("AA, AhAd:0.5")
+	testutils.Equal(t, r.Weight(), 5+0.5)
+
+	r = MustParseRange("76s-54s")
+	testutils.Equal(t, r[0].Cards, [2]int{GetCardID("5h"), GetCardID("4h")})
+
+	for _, notation := range []string{"AKx", "AAs", "1K", "AK+-", "AKs-QJo", "AKs-T8s", "TT-AKs", "AK:2", "AhAh"} {
+		_, err := ParseRange(notation)
+		testutils.Equal(t, errors.Is(err, ErrInvalidRange), true)
+	}
+}
+
+func TestRangeRemove(t *testing.T) {
+	r := MustParseRange("AA, KK")
+	testutils.Equal(t, len(r.Remove(ids("Ah")...)), 9)
+	testutils.Equal(t, len(r.Remove(ids("Ah", "Kd", "2c")...)), 6)
+}
+
+func TestRangeEquity(t *testing.T) {
+	req := RangeEquityRequest{
+		Ranges:     []Range{MustParseRange("AA"), MustParseRange("KK")},
+		Iterations: 20000,
+		Seed:       7,
+		Workers:    2,
+	}
+
+	result, err := CalculateRangeEquity(req)
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, result.Samples, 20000)
+	testutils.Equal(t, math.Abs(result.Players[0].Equity-82) < 2, true)
+
+	again, err := CalculateRangeEquity(req)
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, again, result)
+
+	// blockers on board remove combos of kings
+	req.Board = ids("Kh", "Kd", "2c")
+	result, err = CalculateRangeEquity(req)
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, result.Players[1].Equity > 90, true)
+
+	req.Board = ids("Kh", "Kd", "Kc")
+	_, err = CalculateRangeEquity(req)
+	testutils.Equal(t, errors.Is(err, ErrInvalidRange), true)
+
+	req.Board = nil
+	req.Ranges = []Range{MustParseRange("AhKh"), MustParseRange("AhQh")}
+	_, err = CalculateRangeEquity(req)
+	testutils.Equal(t, err, ErrRangesConflict)
+
+	_, err = CalculateRangeEquity(RangeEquityRequest{Ranges: []Range{MustParseRange("AA")}})
+	testutils.Equal(t, err, ErrNotEnoughPlayers)
+}
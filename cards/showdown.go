@@ -0,0 +1,211 @@
+package cards
+
+import (
+	"sort"
+)
+
+// Hand describe player hand at showdown
+type Hand struct {
+	PlayerID     uint64
+	Cards        []int // hole cards
+	Contribution int   // chips put into pot during the hand
+	Folded       bool
+}
+
+// HandRank describe evaluated hand and its place at the table
+type HandRank struct {
+	PlayerID    uint64
+	Combination *Combination
+	Best        []int // best 5 cards
+	Place       int   // 1 for the best hand, equal places are ties
+}
+
+// Pot describe main or side pot
+type Pot struct {
+	Amount   int
+	Eligible []uint64
+	Winners  []uint64
+}
+
+// Award describe amount won by player
+type Award struct {
+	PlayerID    uint64
+	Amount      int
+	Best        []int
+	Combination *Combination
+}
+
+// ShowdownResult contains ranked hands, pots and winners
+type ShowdownResult struct {
+	Ranks   []HandRank
+	Pots    []Pot
+	Winners []Award
+}
+
+// BestCards return cards of combination with kickers
+func (c *Combination) BestCards() []int {
+	best := make([]int, 0, len(c.Cards)+len(c.Kickers))
+	best = append(best, c.Cards...)
+	best = append(best, c.Kickers...)
+
+	return best
+}
+
+// RankHands evaluate not folded hands with board cards, result sorted from the best hand
+func RankHands(board []int, hands []Hand) []HandRank {
+	ev := BinaryEvaluation{}
+	ranks := make([]HandRank, 0, len(hands))
+	scores := map[uint64]int{}
+
+	for _, h := range hands {
+		if h.Folded {
+			continue
+		}
+
+		cards := make([]int, 0, len(h.Cards)+len(board))
+		cards = append(cards, h.Cards...)
+		cards = append(cards, board...)
+
+		c := ev.Execute(cards)
+		scores[h.PlayerID] = c.Score()
+		ranks = append(ranks, HandRank{
+			PlayerID:    h.PlayerID,
+			Combination: c,
+			Best:        c.BestCards(),
+		})
+	}
+
+	sort.SliceStable(ranks, func(i, j int) bool {
+		return scores[ranks[i].PlayerID] > scores[ranks[j].PlayerID]
+	})
+
+	for i := range ranks {
+		switch {
+		case i == 0:
+			ranks[i].Place = 1
+		case scores[ranks[i].PlayerID] == scores[ranks[i-1].PlayerID]:
+			ranks[i].Place = ranks[i-1].Place
+		default:
+			ranks[i].Place = ranks[i-1].Place + 1
+		}
+	}
+
+	return ranks
+}
+
+// BuildPots split contributions into main and side pots by all-in levels of not folded players
+// Chips of folded players above the highest level added to the last pot
+func BuildPots(hands []Hand) []Pot {
+	var levels []int
+
+	seen := map[int]bool{}
+
+	for _, h := range hands {
+		if !h.Folded && h.Contribution > 0 && !seen[h.Contribution] {
+			seen[h.Contribution] = true
+			levels = append(levels, h.Contribution)
+		}
+	}
+
+	sort.Ints(levels)
+
+	var pots []Pot
+
+	previous := 0
+
+	for i, level := range levels {
+		pot := Pot{}
+
+		for _, h := range hands {
+			upper := level
+			if i == len(levels)-1 && h.Folded {
+				upper = h.Contribution
+			}
+
+			pot.Amount += max(0, min(h.Contribution, upper)-previous)
+
+			if !h.Folded && h.Contribution >= level {
+				pot.Eligible = append(pot.Eligible, h.PlayerID)
+			}
+		}
+
+		if pot.Amount > 0 {
+			pots = append(pots, pot)
+		}
+
+		previous = level
+	}
+
+	return pots
+}
+
+// Showdown rank hands, split pots and award winners
+// Odd chips of split pot given to winners in order of hands
+func Showdown(board []int, hands []Hand) *ShowdownResult {
+	result := &ShowdownResult{
+		Ranks: RankHands(board, hands),
+		Pots:  BuildPots(hands),
+	}
+
+	places := map[uint64]int{}
+	ranks := map[uint64]HandRank{}
+
+	for _, r := range result.Ranks {
+		places[r.PlayerID] = r.Place
+		ranks[r.PlayerID] = r
+	}
+
+	won := map[uint64]int{}
+
+	for i, pot := range result.Pots {
+		best := 0
+
+		for _, id := range pot.Eligible {
+			if best == 0 || places[id] < best {
+				best = places[id]
+			}
+		}
+
+		var winners []uint64
+
+		for _, h := range hands {
+			if places[h.PlayerID] == best && contains(pot.Eligible, h.PlayerID) {
+				winners = append(winners, h.PlayerID)
+			}
+		}
+
+		share, rest := pot.Amount/len(winners), pot.Amount%len(winners)
+
+		for j, id := range winners {
+			won[id] += share
+			if j < rest {
+				won[id]++
+			}
+		}
+
+		result.Pots[i].Winners = winners
+	}
+
+	for _, r := range result.Ranks {
+		if amount, exists := won[r.PlayerID]; exists {
+			result.Winners = append(result.Winners, Award{
+				PlayerID:    r.PlayerID,
+				Amount:      amount,
+				Best:        r.Best,
+				Combination: r.Combination,
+			})
+		}
+	}
+
+	return result
+}
+
+func contains(ids []uint64, id uint64) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,60 @@
+package cards
+
+import (
+	"testing"
+
+	"github.com/InsideGallery/core/testutils"
+)
+
+func TestShowdownSidePots(t *testing.T) {
+	board := GetCardsIDs([]string{"2h", "7d", "9c", "Js", "Kd"})
+	hands := []Hand{
+		{PlayerID: 1, Cards: GetCardsIDs([]string{"Kh", "Kc"}), Contribution: 50},
+		{PlayerID: 2, Cards: GetCardsIDs([]string{"Jh", "Jc"}), Contribution: 100},
+		{PlayerID: 3, Cards: GetCardsIDs([]string{"Ah", "Qc"}), Contribution: 100},
+		{PlayerID: 4, Cards: GetCardsIDs([]string{"Ac", "Ad"}), Contribution: 30, Folded: true},
+	}
+
+	pots := BuildPots(hands)
+	testutils.Equal(t, len(pots), 2)
+	testutils.Equal(t, pots[0].Amount, 180)
+	testutils.Equal(t, pots[0].Eligible, []uint64{1, 2, 3})
+	testutils.Equal(t, pots[1].Amount, 100)
+	testutils.Equal(t, pots[1].Eligible, []uint64{2, 3})
+
+	result := Showdown(board, hands)
+	testutils.Equal(t, len(result.Ranks), 3)
+	testutils.Equal(t, result.Ranks[0].PlayerID, uint64(1))
+	testutils.Equal(t, result.Ranks[1].PlayerID, uint64(2))
+	testutils.Equal(t, result.Ranks[2].Place, 3)
+
+	testutils.Equal(t, len(result.Winners), 2)
+	testutils.Equal(t, result.Winners[0].PlayerID, uint64(1))
+	testutils.Equal(t, result.Winners[0].Amount, 180)
+	testutils.Equal(t, len(result.Winners[0].Best), 5)
+	testutils.Equal(t, GetCombinationName(result.Winners[0].Combination.Combination), "threeOfAKind")
+	testutils.Equal(t, result.Winners[1].PlayerID, uint64(2))
+	testutils.Equal(t, result.Winners[1].Amount, 100)
+}
+
+func TestShowdownSplit(t *testing.T) {
+	board := GetCardsIDs([]string{"Th", "Jd", "Qc", "Ks", "Ad"})
+	hands := []Hand{
+		{PlayerID: 1, Cards: GetCardsIDs([]string{"2h", "3c"}), Contribution: 35},
+		{PlayerID: 2, Cards: GetCardsIDs([]string{"4h", "5c"}), Contribution: 35},
+		{PlayerID: 3, Cards: GetCardsIDs([]string{"6h", "7c"}), Contribution: 30},
+		{PlayerID: 4, Cards: GetCardsIDs([]string{"8h", "8c"}), Contribution: 1, Folded: true},
+	}
+
+	result := Showdown(board, hands)
+	testutils.Equal(t, result.Ranks[2].Place, 1)
+	testutils.Equal(t, len(result.Pots), 2)
+	testutils.Equal(t, result.Pots[0].Winners, []uint64{1, 2, 3})
+
+	amounts := map[uint64]int{}
+	for _, w := range result.Winners {
+		amounts[w.PlayerID] = w.Amount
+	}
+	// main pot 91 split by 3 with odd chip to the first winner, side pot 10 split by 2
+	testutils.Equal(t, amounts, map[uint64]int{1: 36, 2: 35, 3: 30})
+}
@@ -0,0 +1,15 @@
+package solitaire
+
+import "errors"
+
+// All kind of errors for solitaire games
+var (
+	ErrInvalidDeck   = errors.New("invalid deck")
+	ErrInvalidPile   = errors.New("invalid pile")
+	ErrInvalidMove   = errors.New("invalid move")
+	ErrEmptyStock    = errors.New("stock is empty")
+	ErrNothingToUndo = errors.New("nothing to undo")
+	ErrNothingToRedo = errors.New("nothing to redo")
+	ErrUnsolvable    = errors.New("game is unsolvable")
+	ErrSolverLimit   = errors.New("solver limit reached")
+)
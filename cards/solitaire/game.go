@@ -0,0 +1,262 @@
+package solitaire
+
+import (
+	"math/rand/v2"
+	"sync"
+
+	"github.com/InsideGallery/game-core/cards"
+)
+
+// Game solitaire game with undo and redo, game specifics are in rules
+type Game struct {
+	rules  Rules
+	layout Layout
+	undo   []Layout
+	redo   []Layout
+
+	mu sync.Mutex
+}
+
+// NewGame deal all cards of deck from the top
+func NewGame(rules Rules, deck *cards.Deck) (*Game, error) {
+	l, err := rules.Deal(deck.Cards())
+	if err != nil {
+		return nil, err
+	}
+
+	return NewGameWithLayout(rules, l), nil
+}
+
+// NewRandomGame deal cards of rules shuffled by given random source, secure source used if src is nil
+func NewRandomGame(rules Rules, src rand.Source) (*Game, error) {
+	if src == nil {
+		src = cards.CryptoSource{}
+	}
+
+	deck := cards.NewDeckFromCards(rules.Cards())
+	deck.SetSource(src)
+	deck.Shuffle()
+
+	return NewGame(rules, deck)
+}
+
+// NewGameWithLayout return game started from given layout
+func NewGameWithLayout(rules Rules, l Layout) *Game {
+	return &Game{rules: rules, layout: l.Clone()}
+}
+
+// Layout return copy of current layout
+func (g *Game) Layout() Layout {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.layout.Clone()
+}
+
+// Move apply move, DrawMove draws cards from stock
+func (g *Game) Move(m Move) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	next := g.layout.Clone()
+	if err := apply(g.rules, &next, m); err != nil {
+		return err
+	}
+
+	g.push(next)
+
+	return nil
+}
+
+// Draw draw cards from stock
+func (g *Game) Draw() error {
+	return g.Move(DrawMove)
+}
+
+// Undo revert last move
+func (g *Game) Undo() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if len(g.undo) == 0 {
+		return ErrNothingToUndo
+	}
+
+	g.redo = append(g.redo, g.layout)
+	g.layout = g.undo[len(g.undo)-1]
+	g.undo = g.undo[:len(g.undo)-1]
+
+	return nil
+}
+
+// Redo apply last reverted move
+func (g *Game) Redo() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if len(g.redo) == 0 {
+		return ErrNothingToRedo
+	}
+
+	g.undo = append(g.undo, g.layout)
+	g.layout = g.redo[len(g.redo)-1]
+	g.redo = g.redo[:len(g.redo)-1]
+
+	return nil
+}
+
+// Moves return legal moves in current layout, DrawMove included if stock can be drawn
+func (g *Game) Moves() []Move {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return legalMoves(g.rules, &g.layout)
+}
+
+// Won return true if game is won
+func (g *Game) Won() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.rules.Won(&g.layout)
+}
+
+// CanAutoComplete return true if game is won by moving cards to foundations only
+func (g *Game) CanAutoComplete() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	l := g.layout.Clone()
+	autoComplete(g.rules, &l)
+
+	return g.rules.Won(&l)
+}
+
+// AutoComplete move cards to foundations until game is won, it is one step for undo
+func (g *Game) AutoComplete() ([]Move, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	next := g.layout.Clone()
+
+	moves := autoComplete(g.rules, &next)
+	if !g.rules.Won(&next) {
+		return nil, ErrInvalidMove
+	}
+
+	if len(moves) > 0 {
+		g.push(next)
+	}
+
+	return moves, nil
+}
+
+// Solve search moves which win game from current layout, visiting at most maxStates layouts
+func (g *Game) Solve(maxStates int) ([]Move, error) {
+	g.mu.Lock()
+	l := g.layout.Clone()
+	g.mu.Unlock()
+
+	return Solve(g.rules, l, maxStates)
+}
+
+func (g *Game) push(next Layout) {
+	g.undo = append(g.undo, g.layout)
+	g.redo = nil
+	g.layout = next
+}
+
+// apply validate and apply move to layout
+func apply(r Rules, l *Layout, m Move) error {
+	if m.IsDraw() {
+		if err := r.Draw(l); err != nil {
+			return err
+		}
+
+		r.Settle(l)
+
+		return nil
+	}
+
+	from, to := l.Pile(m.From), l.Pile(m.To)
+	if from == nil || to == nil || from == to || m.To.Kind == Stock || m.To.Kind == Waste {
+		return ErrInvalidPile
+	}
+
+	if m.Count < 1 || m.Count > len(from.FaceUp()) {
+		return ErrInvalidMove
+	}
+
+	if err := r.ValidateMove(l, m); err != nil {
+		return err
+	}
+
+	to.Cards = append(to.Cards, from.take(m.Count)...)
+	r.Settle(l)
+
+	return nil
+}
+
+// legalMoves return all legal moves, moves to foundations go first and draw goes last
+func legalMoves(r Rules, l *Layout) []Move {
+	var (
+		sources []PileRef
+		targets []PileRef
+		result  []Move
+	)
+
+	for _, kind := range []PileKind{Foundation, Tableau, Cell} {
+		for i := range l.count(kind) {
+			targets = append(targets, PileRef{Kind: kind, Index: i})
+		}
+	}
+
+	for _, kind := range []PileKind{Waste, Cell, Tableau, Foundation} {
+		for i := range l.count(kind) {
+			sources = append(sources, PileRef{Kind: kind, Index: i})
+		}
+	}
+
+	for _, to := range targets {
+		for _, from := range sources {
+			if from == to {
+				continue
+			}
+
+			for n := range len(l.Pile(from).FaceUp()) {
+				m := Move{From: from, To: to, Count: n + 1}
+				if r.ValidateMove(l, m) == nil {
+					result = append(result, m)
+				}
+			}
+		}
+	}
+
+	if next := l.Clone(); r.Draw(&next) == nil {
+		result = append(result, DrawMove)
+	}
+
+	return result
+}
+
+// autoComplete move cards to foundations while it is possible
+func autoComplete(r Rules, l *Layout) []Move {
+	var result []Move
+
+	for {
+		moved := false
+
+		for _, m := range legalMoves(r, l) {
+			if m.To.Kind == Foundation && apply(r, l, m) == nil {
+				result = append(result, m)
+				moved = true
+
+				break
+			}
+		}
+
+		if !moved {
+			return result
+		}
+	}
+}
@@ -0,0 +1,235 @@
+package solitaire
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/InsideGallery/game-core/cards"
+)
+
+// PileKind describe kind of pile
+type PileKind uint8
+
+// Kinds of piles
+const (
+	Tableau PileKind = iota
+	Foundation
+	Cell
+	Stock
+	Waste
+)
+
+// Pile cards from bottom to top, the first FaceDown cards are hidden
+type Pile struct {
+	Cards    []int
+	FaceDown int
+}
+
+// Len return count of cards in pile
+func (p Pile) Len() int {
+	return len(p.Cards)
+}
+
+// Top return top card, zero if pile is empty
+func (p Pile) Top() int {
+	if len(p.Cards) == 0 {
+		return 0
+	}
+
+	return p.Cards[len(p.Cards)-1]
+}
+
+// FaceUp return visible cards from bottom to top
+func (p Pile) FaceUp() []int {
+	return p.Cards[p.FaceDown:]
+}
+
+// flip turn top card face up if all visible cards were moved
+func (p *Pile) flip() {
+	if p.FaceDown > 0 && p.FaceDown == len(p.Cards) {
+		p.FaceDown--
+	}
+}
+
+// take remove n top cards from pile
+func (p *Pile) take(n int) []int {
+	cut := len(p.Cards) - n
+	result := slices.Clone(p.Cards[cut:])
+	p.Cards = p.Cards[:cut]
+	p.FaceDown = min(p.FaceDown, cut)
+
+	return result
+}
+
+// PileRef reference to pile of layout
+type PileRef struct {
+	Kind  PileKind
+	Index int
+}
+
+// Move describe move of Count top cards between piles, move from stock draws cards
+type Move struct {
+	From  PileRef
+	To    PileRef
+	Count int
+}
+
+// DrawMove move which draws cards from stock
+var DrawMove = Move{From: PileRef{Kind: Stock}}
+
+// IsDraw return true if move draws cards from stock
+func (m Move) IsDraw() bool {
+	return m.From.Kind == Stock
+}
+
+// Layout piles of solitaire game
+type Layout struct {
+	Tableau     []Pile
+	Foundations []Pile
+	Cells       []Pile
+	Stock       Pile
+	Waste       Pile
+}
+
+// Pile return pile by reference, nil if there is no such pile
+func (l *Layout) Pile(ref PileRef) *Pile {
+	var piles []Pile
+
+	switch ref.Kind {
+	case Tableau:
+		piles = l.Tableau
+	case Foundation:
+		piles = l.Foundations
+	case Cell:
+		piles = l.Cells
+	case Stock:
+		return &l.Stock
+	case Waste:
+		return &l.Waste
+	}
+
+	if ref.Index < 0 || ref.Index >= len(piles) {
+		return nil
+	}
+
+	return &piles[ref.Index]
+}
+
+// count return count of piles of given kind
+func (l *Layout) count(kind PileKind) int {
+	switch kind {
+	case Tableau:
+		return len(l.Tableau)
+	case Foundation:
+		return len(l.Foundations)
+	case Cell:
+		return len(l.Cells)
+	}
+
+	return 1
+}
+
+// Clone return deep copy of layout
+func (l *Layout) Clone() Layout {
+	clonePiles := func(piles []Pile) []Pile {
+		result := make([]Pile, len(piles))
+		for i, p := range piles {
+			result[i] = Pile{Cards: slices.Clone(p.Cards), FaceDown: p.FaceDown}
+		}
+
+		return result
+	}
+
+	return Layout{
+		Tableau:     clonePiles(l.Tableau),
+		Foundations: clonePiles(l.Foundations),
+		Cells:       clonePiles(l.Cells),
+		Stock:       Pile{Cards: slices.Clone(l.Stock.Cards), FaceDown: l.Stock.FaceDown},
+		Waste:       Pile{Cards: slices.Clone(l.Waste.Cards), FaceDown: l.Waste.FaceDown},
+	}
+}
+
+// key return string which identify layout, used by solver to skip visited layouts
+func (l *Layout) key() string {
+	var b strings.Builder
+
+	write := func(piles ...Pile) {
+		for _, p := range piles {
+			b.WriteString(strconv.Itoa(p.FaceDown))
+
+			for _, c := range p.Cards {
+				b.WriteByte(' ')
+				b.WriteString(strconv.Itoa(c))
+			}
+
+			b.WriteByte('|')
+		}
+	}
+
+	write(l.Tableau...)
+	write(l.Foundations...)
+	write(l.Cells...)
+	write(l.Stock, l.Waste)
+
+	return b.String()
+}
+
+// value return solitaire rank of card from 1 (ace) to 13 (king)
+func value(c int) int {
+	card, _ := cards.CardFromInt(c)
+	if card.Rank == cards.Ace {
+		return 1
+	}
+
+	return int(card.Rank)
+}
+
+// suitOf return suit of card
+func suitOf(c int) cards.Suit {
+	card, _ := cards.CardFromInt(c)
+	return card.Suit
+}
+
+// red return true for hearts and diamonds
+func red(c int) bool {
+	s := suitOf(c)
+	return s == cards.Hearts || s == cards.Diamonds
+}
+
+// buildsDown return true if card a can be put on card b in descending sequence,
+// alternate colors required if alternate is true, the same suit otherwise if sameSuit is true
+func buildsDown(a, b int, alternate, sameSuit bool) bool {
+	if value(a)+1 != value(b) {
+		return false
+	}
+
+	switch {
+	case alternate:
+		return red(a) != red(b)
+	case sameSuit:
+		return suitOf(a) == suitOf(b)
+	}
+
+	return true
+}
+
+// sequence return true if cards are descending sequence
+func sequence(seq []int, alternate, sameSuit bool) bool {
+	for i := 1; i < len(seq); i++ {
+		if !buildsDown(seq[i], seq[i-1], alternate, sameSuit) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// buildsUp return true if card can be put on foundation: ace on empty pile, next rank of the same suit otherwise
+func buildsUp(c int, p *Pile) bool {
+	if p.Len() == 0 {
+		return value(c) == 1
+	}
+
+	return suitOf(c) == suitOf(p.Top()) && value(c) == value(p.Top())+1
+}
@@ -0,0 +1,357 @@
+package solitaire
+
+import (
+	"slices"
+
+	"github.com/InsideGallery/game-core/cards"
+)
+
+const (
+	suitSize   = 13
+	deckSize   = 52
+	kingValue  = 13
+	spiderSize = 104
+)
+
+// Rules legal moves and layout of solitaire game
+type Rules interface {
+	// Cards return cards used by game
+	Cards() []int
+	// Deal return layout for cards in deal order
+	Deal(cards []int) (Layout, error)
+	// ValidateMove return error if move between existing piles is not allowed, count is already checked
+	ValidateMove(l *Layout, m Move) error
+	// Draw draw cards from stock
+	Draw(l *Layout) error
+	// Settle update layout after move, e.g. flip cards or collect completed sequences
+	Settle(l *Layout)
+	// Won return true if game is won
+	Won(l *Layout) bool
+}
+
+// Klondike rules of klondike: seven tableau piles built down in alternate colors, only king goes to empty pile,
+// stock is drawn to waste by DrawCount cards and waste is recycled when stock is empty
+type Klondike struct {
+	DrawCount int // 1 if zero
+}
+
+// Cards return french deck
+func (Klondike) Cards() []int {
+	return cards.NewDeck().Cards()
+}
+
+// Deal deal one to seven cards to tableau piles with top card face up, the rest goes to stock
+func (Klondike) Deal(deck []int) (Layout, error) {
+	const piles = 7
+
+	if len(deck) != deckSize {
+		return Layout{}, ErrInvalidDeck
+	}
+
+	l := Layout{Tableau: make([]Pile, piles), Foundations: make([]Pile, 4)} //nolint:mnd
+
+	n := 0
+
+	for row := range piles {
+		for i := row; i < piles; i++ {
+			l.Tableau[i].Cards = append(l.Tableau[i].Cards, deck[n])
+			n++
+		}
+	}
+
+	for i := range l.Tableau {
+		l.Tableau[i].FaceDown = i
+	}
+
+	l.Stock = Pile{Cards: slices.Clone(deck[n:]), FaceDown: len(deck) - n}
+
+	return l, nil
+}
+
+// ValidateMove check klondike move
+func (Klondike) ValidateMove(l *Layout, m Move) error {
+	from, to := l.Pile(m.From), l.Pile(m.To)
+	moving := from.Cards[from.Len()-m.Count:]
+
+	switch {
+	case m.From.Kind == Tableau && !sequence(moving, true, false):
+		return ErrInvalidMove
+	case m.From.Kind != Tableau && (m.Count != 1 || (m.From.Kind != Waste && m.From.Kind != Foundation)):
+		return ErrInvalidMove
+	}
+
+	return buildTableauOrFoundation(to, m, moving, func(base int) bool { return value(base) == kingValue }, true, false)
+}
+
+// Draw move cards from stock to waste or recycle waste if stock is empty
+func (k Klondike) Draw(l *Layout) error {
+	if l.Stock.Len() == 0 {
+		if l.Waste.Len() == 0 {
+			return ErrEmptyStock
+		}
+
+		for l.Waste.Len() > 0 {
+			l.Stock.Cards = append(l.Stock.Cards, l.Waste.take(1)...)
+		}
+
+		l.Stock.FaceDown = l.Stock.Len()
+
+		return nil
+	}
+
+	for range min(max(k.DrawCount, 1), l.Stock.Len()) {
+		l.Waste.Cards = append(l.Waste.Cards, l.Stock.take(1)...)
+	}
+
+	return nil
+}
+
+// Settle flip top cards of tableau
+func (Klondike) Settle(l *Layout) {
+	for i := range l.Tableau {
+		l.Tableau[i].flip()
+	}
+}
+
+// Won return true if all cards are on foundations
+func (Klondike) Won(l *Layout) bool {
+	return onFoundations(l) == deckSize
+}
+
+// FreeCell rules of freecell: all cards dealt face up to eight piles, four cells hold one card each,
+// tableau built down in alternate colors, any card goes to empty pile,
+// sequence is moved if there are enough free cells and empty piles to move it card by card
+type FreeCell struct{}
+
+// Cards return french deck
+func (FreeCell) Cards() []int {
+	return cards.NewDeck().Cards()
+}
+
+// Deal deal all cards face up to eight piles
+func (FreeCell) Deal(deck []int) (Layout, error) {
+	const piles = 8
+
+	if len(deck) != deckSize {
+		return Layout{}, ErrInvalidDeck
+	}
+
+	l := Layout{Tableau: make([]Pile, piles), Foundations: make([]Pile, 4), Cells: make([]Pile, 4)} //nolint:mnd
+
+	for i, c := range deck {
+		l.Tableau[i%piles].Cards = append(l.Tableau[i%piles].Cards, c)
+	}
+
+	return l, nil
+}
+
+// ValidateMove check freecell move
+func (FreeCell) ValidateMove(l *Layout, m Move) error {
+	from, to := l.Pile(m.From), l.Pile(m.To)
+	moving := from.Cards[from.Len()-m.Count:]
+
+	switch m.From.Kind {
+	case Tableau:
+		if !sequence(moving, true, false) || m.Count > freeCellCapacity(l, to) {
+			return ErrInvalidMove
+		}
+	case Cell:
+	default:
+		return ErrInvalidMove
+	}
+
+	if m.To.Kind == Cell {
+		if m.Count != 1 || to.Len() != 0 {
+			return ErrInvalidMove
+		}
+
+		return nil
+	}
+
+	return buildTableauOrFoundation(to, m, moving, func(int) bool { return true }, true, false)
+}
+
+// freeCellCapacity return count of cards which can be moved to pile using free cells and empty piles
+func freeCellCapacity(l *Layout, to *Pile) int {
+	cells, empty := 0, 0
+
+	for i := range l.Cells {
+		if l.Cells[i].Len() == 0 {
+			cells++
+		}
+	}
+
+	for i := range l.Tableau {
+		if l.Tableau[i].Len() == 0 && &l.Tableau[i] != to {
+			empty++
+		}
+	}
+
+	return (cells + 1) << empty
+}
+
+// Draw return error because freecell has no stock
+func (FreeCell) Draw(*Layout) error {
+	return ErrEmptyStock
+}
+
+// Settle do nothing, all cards are face up
+func (FreeCell) Settle(*Layout) {}
+
+// Won return true if all cards are on foundations
+func (FreeCell) Won(l *Layout) bool {
+	return onFoundations(l) == deckSize
+}
+
+// Spider rules of spider: two decks of given count of suits dealt to ten piles, any card built down by rank,
+// only sequence of the same suit is moved, completed sequence from king to ace goes to foundation,
+// stock deals one card to every pile when there are no empty piles
+type Spider struct {
+	Suits int // 1, 2 or 4, 1 if zero
+}
+
+// Cards return 104 cards of given count of suits
+func (s Spider) Cards() []int {
+	suits := []cards.Suit{cards.Spades, cards.Hearts, cards.Clubs, cards.Diamonds}[:s.suits()]
+
+	var result []int
+
+	for len(result) < spiderSize {
+		for _, st := range suits {
+			for _, r := range cards.FrenchRanks {
+				id, _ := cards.NewCard(r, st).Int()
+				result = append(result, id)
+			}
+		}
+	}
+
+	return result
+}
+
+func (s Spider) suits() int {
+	switch s.Suits {
+	case 2, 4: //nolint:mnd
+		return s.Suits
+	}
+
+	return 1
+}
+
+// Deal deal 54 cards to ten piles with top cards face up, the rest goes to stock
+func (Spider) Deal(deck []int) (Layout, error) {
+	const (
+		piles = 10
+		dealt = 54
+	)
+
+	if len(deck) != spiderSize {
+		return Layout{}, ErrInvalidDeck
+	}
+
+	l := Layout{Tableau: make([]Pile, piles), Foundations: make([]Pile, spiderSize/suitSize)}
+
+	for i, c := range deck[:dealt] {
+		l.Tableau[i%piles].Cards = append(l.Tableau[i%piles].Cards, c)
+	}
+
+	for i := range l.Tableau {
+		l.Tableau[i].FaceDown = l.Tableau[i].Len() - 1
+	}
+
+	l.Stock = Pile{Cards: slices.Clone(deck[dealt:]), FaceDown: len(deck) - dealt}
+
+	return l, nil
+}
+
+// ValidateMove check spider move
+func (Spider) ValidateMove(l *Layout, m Move) error {
+	from, to := l.Pile(m.From), l.Pile(m.To)
+	moving := from.Cards[from.Len()-m.Count:]
+
+	if m.From.Kind != Tableau || m.To.Kind != Tableau || !sequence(moving, false, true) {
+		return ErrInvalidMove
+	}
+
+	if to.Len() > 0 && !buildsDown(moving[0], to.Top(), false, false) {
+		return ErrInvalidMove
+	}
+
+	return nil
+}
+
+// Draw deal one card face up to every pile
+func (Spider) Draw(l *Layout) error {
+	if l.Stock.Len() == 0 {
+		return ErrEmptyStock
+	}
+
+	for i := range l.Tableau {
+		if l.Tableau[i].Len() == 0 {
+			return ErrInvalidMove
+		}
+	}
+
+	for i := range l.Tableau {
+		if l.Stock.Len() > 0 {
+			l.Tableau[i].Cards = append(l.Tableau[i].Cards, l.Stock.take(1)...)
+		}
+	}
+
+	return nil
+}
+
+// Settle collect completed sequences and flip top cards
+func (Spider) Settle(l *Layout) {
+	for i := range l.Tableau {
+		p := &l.Tableau[i]
+
+		if len(p.FaceUp()) >= suitSize {
+			run := p.Cards[p.Len()-suitSize:]
+			if value(run[0]) == kingValue && sequence(run, false, true) {
+				for j := range l.Foundations {
+					if l.Foundations[j].Len() == 0 {
+						l.Foundations[j].Cards = p.take(suitSize)
+						break
+					}
+				}
+			}
+		}
+
+		p.flip()
+	}
+}
+
+// Won return true if all sequences are completed
+func (Spider) Won(l *Layout) bool {
+	return onFoundations(l) == spiderSize
+}
+
+// buildTableauOrFoundation check cards can be put on tableau or foundation pile
+func buildTableauOrFoundation(to *Pile, m Move, moving []int, emptyTableau func(base int) bool, alternate, sameSuit bool) error {
+	switch m.To.Kind {
+	case Tableau:
+		if to.Len() == 0 && emptyTableau(moving[0]) {
+			return nil
+		}
+
+		if to.Len() > 0 && buildsDown(moving[0], to.Top(), alternate, sameSuit) {
+			return nil
+		}
+	case Foundation:
+		if m.Count == 1 && m.From.Kind != Foundation && buildsUp(moving[0], to) {
+			return nil
+		}
+	}
+
+	return ErrInvalidMove
+}
+
+// onFoundations return count of cards on foundations
+func onFoundations(l *Layout) int {
+	n := 0
+	for i := range l.Foundations {
+		n += l.Foundations[i].Len()
+	}
+
+	return n
+}
@@ -0,0 +1,283 @@
+package solitaire
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/InsideGallery/game-core/cards"
+
+	"github.com/InsideGallery/core/testutils"
+)
+
+func ids(s string) []int {
+	return cards.MustParseCards(s)
+}
+
+// run return cards of suit from ace to king
+func run(suit string) []int {
+	var result []int
+	for _, r := range "A23456789TJQK" {
+		result = append(result, ids(string(r)+suit)...)
+	}
+
+	return result
+}
+
+func lens(piles []Pile) []int {
+	result := make([]int, len(piles))
+	for i := range piles {
+		result[i] = piles[i].Len()
+	}
+
+	return result
+}
+
+func TestDeal(t *testing.T) {
+	g, err := NewGame(Klondike{}, cards.NewDeck())
+	testutils.Equal(t, err, nil)
+
+	l := g.Layout()
+	testutils.Equal(t, lens(l.Tableau), []int{1, 2, 3, 4, 5, 6, 7})
+	testutils.Equal(t, l.Tableau[6].FaceDown, 6)
+	testutils.Equal(t, l.Stock.Len(), 24)
+	testutils.Equal(t, len(l.Foundations), 4)
+
+	g, err = NewRandomGame(FreeCell{}, rand.NewPCG(1, 2))
+	testutils.Equal(t, err, nil)
+
+	l = g.Layout()
+	testutils.Equal(t, lens(l.Tableau), []int{7, 7, 7, 7, 6, 6, 6, 6})
+	testutils.Equal(t, l.Tableau[0].FaceDown, 0)
+	testutils.Equal(t, len(l.Cells), 4)
+
+	g, err = NewRandomGame(Spider{Suits: 2}, rand.NewPCG(1, 2))
+	testutils.Equal(t, err, nil)
+
+	l = g.Layout()
+	testutils.Equal(t, lens(l.Tableau), []int{6, 6, 6, 6, 5, 5, 5, 5, 5, 5})
+	testutils.Equal(t, l.Tableau[0].FaceDown, 5)
+	testutils.Equal(t, l.Stock.Len(), 50)
+	testutils.Equal(t, len(l.Foundations), 8)
+
+	_, err = NewGame(Spider{}, cards.NewDeck())
+	testutils.Equal(t, err, ErrInvalidDeck)
+}
+
+func TestKlondikeMoves(t *testing.T) {
+	g := NewGameWithLayout(Klondike{}, Layout{
+		Tableau:     []Pile{{Cards: ids("2c 8h"), FaceDown: 1}, {Cards: ids("9s")}, {}},
+		Foundations: make([]Pile, 4),
+		Waste:       Pile{Cards: ids("Ac")},
+	})
+
+	testutils.Equal(t, g.Move(Move{From: PileRef{Kind: Tableau}, To: PileRef{Kind: Tableau, Index: 1}, Count: 2}), ErrInvalidMove)
+	testutils.Equal(t, g.Move(Move{From: PileRef{Kind: Tableau}, To: PileRef{Kind: Stock}, Count: 1}), ErrInvalidPile)
+	testutils.Equal(t, g.Move(Move{From: PileRef{Kind: Tableau}, To: PileRef{Kind: Tableau, Index: 1}, Count: 1}), nil)
+
+	l := g.Layout()
+	testutils.Equal(t, l.Tableau[0], Pile{Cards: ids("2c")})
+	testutils.Equal(t, l.Tableau[1].Cards, ids("9s 8h"))
+
+	// only king goes to empty pile
+	testutils.Equal(t, g.Move(Move{From: PileRef{Kind: Tableau, Index: 1}, To: PileRef{Kind: Tableau, Index: 2}, Count: 2}), ErrInvalidMove)
+	testutils.Equal(t, g.Move(Move{From: PileRef{Kind: Tableau}, To: PileRef{Kind: Foundation}, Count: 1}), ErrInvalidMove)
+	testutils.Equal(t, g.Move(Move{From: PileRef{Kind: Waste}, To: PileRef{Kind: Foundation}, Count: 1}), nil)
+	testutils.Equal(t, g.Move(Move{From: PileRef{Kind: Tableau}, To: PileRef{Kind: Foundation}, Count: 1}), nil)
+	testutils.Equal(t, g.Layout().Foundations[0].Cards, ids("Ac 2c"))
+
+	testutils.Equal(t, g.Undo(), nil)
+	testutils.Equal(t, g.Undo(), nil)
+	testutils.Equal(t, g.Undo(), nil)
+	testutils.Equal(t, g.Undo(), ErrNothingToUndo)
+	testutils.Equal(t, g.Layout().Tableau[0], Pile{Cards: ids("2c 8h"), FaceDown: 1})
+
+	testutils.Equal(t, g.Redo(), nil)
+	testutils.Equal(t, g.Layout(), l)
+
+	// waste is recycled when stock is empty
+	testutils.Equal(t, g.Draw(), nil)
+	testutils.Equal(t, g.Layout().Stock, Pile{Cards: ids("Ac"), FaceDown: 1})
+	testutils.Equal(t, g.Undo(), nil)
+
+	testutils.Equal(t, g.Moves(), []Move{
+		{From: PileRef{Kind: Waste}, To: PileRef{Kind: Foundation}, Count: 1},
+		{From: PileRef{Kind: Waste}, To: PileRef{Kind: Foundation, Index: 1}, Count: 1},
+		{From: PileRef{Kind: Waste}, To: PileRef{Kind: Foundation, Index: 2}, Count: 1},
+		{From: PileRef{Kind: Waste}, To: PileRef{Kind: Foundation, Index: 3}, Count: 1},
+		DrawMove,
+	})
+
+	testutils.Equal(t, g.Move(Move{From: PileRef{Kind: Tableau}, To: PileRef{Kind: Tableau, Index: 1}, Count: 1}), ErrInvalidMove)
+	testutils.Equal(t, g.Redo(), nil)
+	testutils.Equal(t, g.Undo(), nil)
+	testutils.Equal(t, g.Move(Move{From: PileRef{Kind: Waste}, To: PileRef{Kind: Foundation, Index: 3}, Count: 1}), nil)
+	testutils.Equal(t, g.Redo(), ErrNothingToRedo)
+}
+
+func TestKlondikeDraw(t *testing.T) {
+	g := NewGameWithLayout(Klondike{DrawCount: 3}, Layout{
+		Tableau:     make([]Pile, 7),
+		Foundations: make([]Pile, 4),
+		Stock:       Pile{Cards: ids("Ac 2c 3c 4c"), FaceDown: 4},
+	})
+
+	testutils.Equal(t, g.Draw(), nil)
+	testutils.Equal(t, g.Layout().Waste.Cards, ids("4c 3c 2c"))
+	testutils.Equal(t, g.Layout().Stock, Pile{Cards: ids("Ac"), FaceDown: 1})
+
+	testutils.Equal(t, g.Draw(), nil)
+	testutils.Equal(t, g.Layout().Waste.Cards, ids("4c 3c 2c Ac"))
+
+	// waste is recycled to stock in original order
+	testutils.Equal(t, g.Draw(), nil)
+	testutils.Equal(t, g.Layout().Stock, Pile{Cards: ids("Ac 2c 3c 4c"), FaceDown: 4})
+	testutils.Equal(t, g.Layout().Waste.Len(), 0)
+
+	g = NewGameWithLayout(Klondike{}, Layout{Tableau: make([]Pile, 7), Foundations: make([]Pile, 4)})
+	testutils.Equal(t, g.Draw(), ErrEmptyStock)
+}
+
+func TestFreeCell(t *testing.T) {
+	l := Layout{
+		Tableau:     []Pile{{Cards: ids("Kd Qs Jh Tc 9h")}, {Cards: ids("Kc")}, {}, {}},
+		Foundations: make([]Pile, 4),
+		Cells:       []Pile{{Cards: ids("2d")}, {}},
+	}
+
+	testutils.Equal(t, freeCellCapacity(&l, &l.Tableau[1]), 8)
+	testutils.Equal(t, freeCellCapacity(&l, &l.Tableau[2]), 4)
+
+	g := NewGameWithLayout(FreeCell{}, l)
+	testutils.Equal(t, g.Move(Move{From: PileRef{Kind: Tableau}, To: PileRef{Kind: Tableau, Index: 2}, Count: 5}), ErrInvalidMove)
+	testutils.Equal(t, g.Move(Move{From: PileRef{Kind: Tableau}, To: PileRef{Kind: Tableau, Index: 2}, Count: 4}), nil)
+	testutils.Equal(t, g.Move(Move{From: PileRef{Kind: Tableau}, To: PileRef{Kind: Cell}, Count: 1}), ErrInvalidMove)
+	testutils.Equal(t, g.Move(Move{From: PileRef{Kind: Tableau}, To: PileRef{Kind: Cell, Index: 1}, Count: 1}), nil)
+	testutils.Equal(t, g.Move(Move{From: PileRef{Kind: Cell}, To: PileRef{Kind: Tableau, Index: 3}, Count: 1}), nil)
+
+	l = g.Layout()
+	testutils.Equal(t, lens(l.Tableau), []int{0, 1, 4, 1})
+	testutils.Equal(t, lens(l.Cells), []int{0, 1})
+}
+
+func TestSpider(t *testing.T) {
+	spades := run("s")
+	king := append([]int{}, spades[1:]...)
+
+	l := Layout{
+		Tableau:     make([]Pile, 10),
+		Foundations: make([]Pile, 8),
+		Stock:       Pile{Cards: ids("2h 3h"), FaceDown: 2},
+	}
+
+	for i := range l.Tableau {
+		l.Tableau[i] = Pile{Cards: ids("5h")}
+	}
+
+	l.Tableau[0] = Pile{Cards: append(ids("5h"), reverse(king)...), FaceDown: 1}
+	l.Tableau[1] = Pile{Cards: spades[:1]}
+	l.Tableau[2] = Pile{Cards: ids("6d 5h")}
+
+	g := NewGameWithLayout(Spider{}, l)
+	testutils.Equal(t, g.Move(Move{From: PileRef{Kind: Tableau, Index: 1}, To: PileRef{Kind: Tableau, Index: 2}, Count: 1}), ErrInvalidMove)
+	testutils.Equal(t, g.Move(Move{From: PileRef{Kind: Tableau, Index: 1}, To: PileRef{Kind: Tableau, Index: 2}, Count: 2}), ErrInvalidMove)
+	testutils.Equal(t, g.Move(Move{From: PileRef{Kind: Tableau, Index: 1}, To: PileRef{Kind: Tableau}, Count: 1}), nil)
+
+	l = g.Layout()
+	testutils.Equal(t, l.Tableau[0], Pile{Cards: ids("5h")})
+	testutils.Equal(t, l.Foundations[0].Cards, reverse(spades))
+
+	testutils.Equal(t, g.Draw(), ErrInvalidMove)
+	testutils.Equal(t, g.Move(Move{From: PileRef{Kind: Tableau, Index: 2}, To: PileRef{Kind: Tableau, Index: 1}, Count: 1}), nil)
+	testutils.Equal(t, g.Draw(), nil)
+
+	l = g.Layout()
+	testutils.Equal(t, l.Tableau[0].Cards, ids("5h 3h"))
+	testutils.Equal(t, l.Tableau[1].Cards, ids("5h 2h"))
+	testutils.Equal(t, l.Tableau[2].Cards, ids("6d"))
+	testutils.Equal(t, l.Stock.Len(), 0)
+}
+
+func reverse(c []int) []int {
+	result := make([]int, len(c))
+	for i := range c {
+		result[len(c)-1-i] = c[i]
+	}
+
+	return result
+}
+
+// lastHearts layout with all cards on foundations except hearts on one tableau pile
+func lastHearts(hearts []int) Layout {
+	return Layout{
+		Tableau:     []Pile{{Cards: hearts, FaceDown: len(hearts) - 1}, {}},
+		Foundations: []Pile{{Cards: run("c")}, {Cards: run("d")}, {Cards: run("s")}, {}},
+	}
+}
+
+func TestAutoComplete(t *testing.T) {
+	g := NewGameWithLayout(Klondike{}, lastHearts(reverse(run("h"))))
+	testutils.Equal(t, g.CanAutoComplete(), true)
+
+	moves, err := g.AutoComplete()
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, len(moves), 13)
+	testutils.Equal(t, g.Won(), true)
+
+	testutils.Equal(t, g.Undo(), nil)
+	testutils.Equal(t, g.Won(), false)
+
+	g = NewGameWithLayout(Klondike{}, lastHearts(run("h")))
+	testutils.Equal(t, g.CanAutoComplete(), false)
+
+	_, err = g.AutoComplete()
+	testutils.Equal(t, err, ErrInvalidMove)
+}
+
+func TestSolve(t *testing.T) {
+	hearts := reverse(run("h"))
+	hearts[11], hearts[12] = hearts[12], hearts[11] // 2h on top of ace
+
+	l := lastHearts(hearts)
+	l.Tableau[0].FaceDown = 0
+	l.Cells = make([]Pile, 1)
+
+	g := NewGameWithLayout(FreeCell{}, l)
+	testutils.Equal(t, g.CanAutoComplete(), false)
+
+	moves, err := g.Solve(1000)
+	testutils.Equal(t, err, nil)
+
+	for _, m := range moves {
+		testutils.Equal(t, g.Move(m), nil)
+	}
+
+	testutils.Equal(t, g.Won(), true)
+
+	// 2c needs 3h which is on foundation
+	l = Layout{
+		Tableau: []Pile{
+			{Cards: ids("Ac 2c")}, {Cards: ids("4s")},
+			{Cards: reverse(run("h")[3:])}, {Cards: reverse(run("s")[4:])}, {Cards: reverse(run("c")[2:])},
+		},
+		Foundations: []Pile{{Cards: run("h")[:3]}, {Cards: run("s")[:3]}, {}, {Cards: run("d")}},
+	}
+
+	moves, err = Solve(Klondike{}, l, 10000)
+	testutils.Equal(t, err, nil)
+
+	g = NewGameWithLayout(Klondike{}, l)
+	for _, m := range moves {
+		testutils.Equal(t, g.Move(m), nil)
+	}
+
+	testutils.Equal(t, g.Won(), true)
+
+	_, err = Solve(Klondike{}, lastHearts(run("h")), 1000)
+	testutils.Equal(t, err, ErrUnsolvable)
+
+	g, err = NewRandomGame(Klondike{}, rand.NewPCG(1, 2))
+	testutils.Equal(t, err, nil)
+
+	_, err = g.Solve(10)
+	testutils.Equal(t, err, ErrSolverLimit)
+}
@@ -0,0 +1,80 @@
+package solitaire
+
+// Solve search moves which win game from layout by depth first search visiting at most maxStates layouts,
+// return ErrUnsolvable if all reachable layouts were visited and ErrSolverLimit if limit was reached
+func Solve(r Rules, l Layout, maxStates int) ([]Move, error) {
+	s := solver{rules: r, visited: map[string]bool{}, limit: maxStates}
+
+	l = l.Clone()
+	if s.search(&l) {
+		return s.path, nil
+	}
+
+	if s.limited {
+		return nil, ErrSolverLimit
+	}
+
+	return nil, ErrUnsolvable
+}
+
+// solver state of bounded search
+type solver struct {
+	rules   Rules
+	visited map[string]bool
+	path    []Move
+	limit   int
+	limited bool
+}
+
+func (s *solver) search(l *Layout) bool {
+	if s.rules.Won(l) {
+		return true
+	}
+
+	key := l.key()
+	if s.visited[key] {
+		return false
+	}
+
+	if len(s.visited) >= s.limit {
+		s.limited = true
+		return false
+	}
+
+	s.visited[key] = true
+
+	for _, m := range legalMoves(s.rules, l) {
+		if pointless(l, m) {
+			continue
+		}
+
+		next := l.Clone()
+		if apply(s.rules, &next, m) != nil {
+			continue
+		}
+
+		s.path = append(s.path, m)
+
+		if s.search(&next) {
+			return true
+		}
+
+		s.path = s.path[:len(s.path)-1]
+
+		if s.limited {
+			return false
+		}
+	}
+
+	return false
+}
+
+// pointless return true for moves which never help: whole pile to empty tableau pile,
+// cards back from foundation are kept because they may hold lower cards of other color
+func pointless(l *Layout, m Move) bool {
+	if m.IsDraw() || m.From.Kind != Tableau || m.To.Kind != Tableau {
+		return false
+	}
+
+	return l.Pile(m.To).Len() == 0 && l.Pile(m.From).Len() == m.Count
+}
@@ -0,0 +1,17 @@
+package trick
+
+import "errors"
+
+// All kind of errors for trick-taking games
+var (
+	ErrInvalidConfig  = errors.New("invalid game config")
+	ErrInvalidSeat    = errors.New("invalid seat")
+	ErrInvalidDeal    = errors.New("invalid deal")
+	ErrHandInProgress = errors.New("hand in progress")
+	ErrWrongPhase     = errors.New("action not allowed in current phase")
+	ErrNotYourTurn    = errors.New("not your turn")
+	ErrInvalidBid     = errors.New("invalid bid")
+	ErrCardNotInHand  = errors.New("card not in hand")
+	ErrMustFollowSuit = errors.New("must follow suit")
+	ErrCardNotAllowed = errors.New("card not allowed")
+)
@@ -0,0 +1,304 @@
+package trick
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/InsideGallery/game-core/cards"
+)
+
+// Phase describe state of hand
+type Phase uint8
+
+// Hand phases
+const (
+	PhaseIdle Phase = iota
+	PhaseBidding
+	PhasePlaying
+	PhaseFinished
+)
+
+// Game trick-taking engine, it validates bids and plays and resolves tricks, game specifics are in rules
+type Game struct {
+	rules Rules
+	state State
+	phase Phase
+	turn  int
+
+	mu sync.Mutex
+}
+
+// NewGame return game for given count of players, BaseRules used if rules is nil
+func NewGame(players int, rules Rules) (*Game, error) {
+	if players < 2 { //nolint:mnd
+		return nil, ErrInvalidConfig
+	}
+
+	if rules == nil {
+		rules = BaseRules{}
+	}
+
+	return &Game{
+		rules: rules,
+		state: State{Players: players, Scores: make([]int, players)},
+		turn:  -1,
+	}, nil
+}
+
+// Deal start new hand with given hands of seats
+func (g *Game) Deal(dealer int, hands [][]cards.Card) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.phase == PhaseBidding || g.phase == PhasePlaying {
+		return ErrHandInProgress
+	}
+
+	if dealer < 0 || dealer >= g.state.Players {
+		return ErrInvalidSeat
+	}
+
+	if len(hands) != g.state.Players {
+		return ErrInvalidDeal
+	}
+
+	g.state.Dealer = dealer
+	g.state.Trump = cards.SuitNone
+	g.state.Bids = nil
+	g.state.Tricks = nil
+	g.state.Won = make([]int, g.state.Players)
+	g.state.Hands = make([][]cards.Card, len(hands))
+
+	for i, h := range hands {
+		g.state.Hands[i] = slices.Clone(h)
+	}
+
+	if g.rules.StartHand(&g.state) {
+		g.phase = PhaseBidding
+		g.turn = g.state.Next(dealer)
+
+		return nil
+	}
+
+	g.startPlay()
+
+	return nil
+}
+
+// Bid make bid of seat during bidding phase
+func (g *Game) Bid(seat int, bid Bid) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.phase != PhaseBidding {
+		return ErrWrongPhase
+	}
+
+	if seat != g.turn {
+		return ErrNotYourTurn
+	}
+
+	bid.Seat = seat
+
+	if err := g.rules.ValidateBid(&g.state, bid); err != nil {
+		return err
+	}
+
+	g.state.Bids = append(g.state.Bids, bid)
+	g.turn = g.state.Next(seat)
+
+	if g.rules.BiddingDone(&g.state) {
+		g.startPlay()
+	}
+
+	return nil
+}
+
+// Play play card of seat to current trick
+func (g *Game) Play(seat int, c cards.Card) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.phase != PhasePlaying {
+		return ErrWrongPhase
+	}
+
+	if seat != g.turn {
+		return ErrNotYourTurn
+	}
+
+	if err := g.canPlay(seat, c); err != nil {
+		return err
+	}
+
+	hand := g.state.Hands[seat]
+	i := slices.IndexFunc(hand, func(h cards.Card) bool { return h == c })
+	g.state.Hands[seat] = slices.Delete(hand, i, i+1)
+	g.state.Current.Plays = append(g.state.Current.Plays, Play{Seat: seat, Card: c})
+	g.turn = g.state.Next(seat)
+
+	if len(g.state.Current.Plays) < g.state.Players {
+		return nil
+	}
+
+	winner := g.winner()
+	g.state.Current.Winner = winner
+	g.state.Won[winner]++
+	g.state.Tricks = append(g.state.Tricks, g.state.Current)
+	g.state.Current = Trick{Leader: winner, Winner: -1}
+	g.turn = winner
+
+	if len(g.state.Hands[winner]) == 0 {
+		g.finish()
+	}
+
+	return nil
+}
+
+// Playable return cards which seat can play now
+func (g *Game) Playable(seat int) []cards.Card {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.phase != PhasePlaying || seat != g.turn {
+		return nil
+	}
+
+	var result []cards.Card
+
+	for _, c := range g.state.Hands[seat] {
+		if g.canPlay(seat, c) == nil {
+			result = append(result, c)
+		}
+	}
+
+	return result
+}
+
+// Phase return phase of hand
+func (g *Game) Phase() Phase {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.phase
+}
+
+// Turn return seat which should bid or play, -1 if there is no one
+func (g *Game) Turn() int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.turn
+}
+
+// Hand return cards of seat
+func (g *Game) Hand(seat int) []cards.Card {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if seat < 0 || seat >= len(g.state.Hands) {
+		return nil
+	}
+
+	return slices.Clone(g.state.Hands[seat])
+}
+
+// Trump return trump suit, SuitNone if there is no trump
+func (g *Game) Trump() cards.Suit {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.state.Trump
+}
+
+// Scores return total scores of seats
+func (g *Game) Scores() []int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return slices.Clone(g.state.Scores)
+}
+
+// State return copy of game state
+func (g *Game) State() State {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	s := g.state
+	s.Hands = make([][]cards.Card, len(g.state.Hands))
+
+	for i, h := range g.state.Hands {
+		s.Hands[i] = slices.Clone(h)
+	}
+
+	s.Bids = slices.Clone(s.Bids)
+	s.Tricks = slices.Clone(s.Tricks)
+	s.Current.Plays = slices.Clone(s.Current.Plays)
+	s.Won = slices.Clone(s.Won)
+	s.Scores = slices.Clone(s.Scores)
+
+	return s
+}
+
+func (g *Game) startPlay() {
+	g.phase = PhasePlaying
+	g.turn = g.rules.Leader(&g.state)
+	g.state.Current = Trick{Leader: g.turn, Winner: -1}
+}
+
+// canPlay check card is in hand, follows led suit and allowed by rules
+func (g *Game) canPlay(seat int, c cards.Card) error {
+	hand := g.state.Hands[seat]
+	if !slices.Contains(hand, c) {
+		return ErrCardNotInHand
+	}
+
+	led := g.state.LedSuit(g.rules)
+	if led != cards.SuitNone && g.rules.Suit(&g.state, c) != led {
+		for _, h := range hand {
+			if g.rules.Suit(&g.state, h) == led {
+				return ErrMustFollowSuit
+			}
+		}
+	}
+
+	return g.rules.ValidatePlay(&g.state, seat, c)
+}
+
+// winner return seat with the highest trump or the highest card of led suit, the first played wins ties
+func (g *Game) winner() int {
+	led := g.state.LedSuit(g.rules)
+	best := g.state.Current.Plays[0]
+
+	for _, p := range g.state.Current.Plays[1:] {
+		if g.beats(p.Card, best.Card, led) {
+			best = p
+		}
+	}
+
+	return best.Seat
+}
+
+// beats return true if card a beats card b
+func (g *Game) beats(a, b cards.Card, led cards.Suit) bool {
+	sa, sb := g.rules.Suit(&g.state, a), g.rules.Suit(&g.state, b)
+	trump := g.state.Trump
+
+	switch {
+	case trump != cards.SuitNone && sa == trump && sb != trump:
+		return true
+	case sa != sb || (sa != led && sa != trump):
+		return false
+	}
+
+	return g.rules.Power(&g.state, a) > g.rules.Power(&g.state, b)
+}
+
+func (g *Game) finish() {
+	for i, score := range g.rules.Score(&g.state) {
+		g.state.Scores[i] += score
+	}
+
+	g.phase = PhaseFinished
+	g.turn = -1
+}
@@ -0,0 +1,80 @@
+package trick
+
+import "github.com/InsideGallery/game-core/cards"
+
+const (
+	heartsMoon       = 26
+	queenSpadesScore = 13
+)
+
+// HeartsRules rules of hearts: two of clubs leads, hearts can not be led until broken,
+// every heart is a point, queen of spades is thirteen points, shooting the moon gives points to others
+type HeartsRules struct {
+	BaseRules
+}
+
+// Leader return seat holding two of clubs
+func (HeartsRules) Leader(s *State) int {
+	for seat, hand := range s.Hands {
+		for _, c := range hand {
+			if c == cards.NewCard(cards.Two, cards.Clubs) {
+				return seat
+			}
+		}
+	}
+
+	return s.Next(s.Dealer)
+}
+
+// ValidatePlay check first lead and broken hearts
+func (r HeartsRules) ValidatePlay(s *State, seat int, c cards.Card) error {
+	if len(s.Current.Plays) > 0 {
+		return nil
+	}
+
+	if len(s.Tricks) == 0 && c != cards.NewCard(cards.Two, cards.Clubs) {
+		return ErrCardNotAllowed
+	}
+
+	if c.Suit != cards.Hearts || s.Played(r, cards.Hearts) {
+		return nil
+	}
+
+	for _, h := range s.Hands[seat] {
+		if h.Suit != cards.Hearts {
+			return ErrCardNotAllowed
+		}
+	}
+
+	return nil
+}
+
+// Score return penalty points of seats
+func (HeartsRules) Score(s *State) []int {
+	points := make([]int, s.Players)
+
+	for _, t := range s.Tricks {
+		for _, p := range t.Plays {
+			switch {
+			case p.Card.Suit == cards.Hearts:
+				points[t.Winner]++
+			case p.Card == cards.NewCard(cards.Queen, cards.Spades):
+				points[t.Winner] += queenSpadesScore
+			}
+		}
+	}
+
+	for seat, p := range points {
+		if p == heartsMoon {
+			for i := range points {
+				points[i] = heartsMoon
+			}
+
+			points[seat] = 0
+
+			break
+		}
+	}
+
+	return points
+}
@@ -0,0 +1,132 @@
+package trick
+
+import (
+	"slices"
+
+	"github.com/InsideGallery/game-core/cards"
+)
+
+// Bid describe bid of seat, suit is trump or strain, SuitNone used for no trump
+type Bid struct {
+	Seat  int
+	Value int
+	Suit  cards.Suit
+	Pass  bool
+}
+
+// Play describe card played by seat
+type Play struct {
+	Seat int
+	Card cards.Card
+}
+
+// Trick describe cards played in one trick, winner is -1 until trick finished
+type Trick struct {
+	Leader int
+	Plays  []Play
+	Winner int
+}
+
+// State describe hand of trick-taking game, given to rules hooks which may change it
+type State struct {
+	Players int
+	Dealer  int
+	Hands   [][]cards.Card
+	Trump   cards.Suit // SuitNone if there is no trump
+	Bids    []Bid
+	Tricks  []Trick // finished tricks of current hand
+	Current Trick
+	Won     []int // count of tricks won by seat in current hand
+	Scores  []int // total scores of all hands
+}
+
+// Next return seat after given one
+func (s *State) Next(seat int) int {
+	return (seat + 1) % s.Players
+}
+
+// LedSuit return effective suit of the first card of current trick, SuitNone if trick is empty
+func (s *State) LedSuit(r Rules) cards.Suit {
+	if len(s.Current.Plays) == 0 {
+		return cards.SuitNone
+	}
+
+	return r.Suit(s, s.Current.Plays[0].Card)
+}
+
+// Played return true if any card of given effective suit was played in current hand
+func (s *State) Played(r Rules, suit cards.Suit) bool {
+	for _, t := range slices.Concat(s.Tricks, []Trick{s.Current}) {
+		for _, p := range t.Plays {
+			if r.Suit(s, p.Card) == suit {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Rules hooks of specific game, hooks are called while game is locked
+type Rules interface {
+	// StartHand prepare dealt hand, e.g. set trump, return true if hand starts with bidding
+	StartHand(s *State) bool
+	// ValidateBid return error if bid is not allowed
+	ValidateBid(s *State, bid Bid) error
+	// BiddingDone return true if bidding is finished, may set trump by the contract
+	BiddingDone(s *State) bool
+	// Leader return seat which leads the first trick
+	Leader(s *State) int
+	// Suit return effective suit of card, e.g. left bower belongs to trump suit
+	Suit(s *State, c cards.Card) cards.Suit
+	// Power return strength of card inside its effective suit
+	Power(s *State, c cards.Card) int
+	// ValidatePlay return error if card can not be played, called after follow suit check
+	ValidatePlay(s *State, seat int, c cards.Card) error
+	// Score return points of seats for finished hand
+	Score(s *State) []int
+}
+
+// BaseRules default rules: no bidding, no trump, player left to dealer leads, ace high, trick is a point
+// Games embed it and override needed hooks
+type BaseRules struct{}
+
+// StartHand start hand without bidding
+func (BaseRules) StartHand(*State) bool {
+	return false
+}
+
+// ValidateBid allow any bid
+func (BaseRules) ValidateBid(*State, Bid) error {
+	return nil
+}
+
+// BiddingDone finish bidding when every seat made a bid
+func (BaseRules) BiddingDone(s *State) bool {
+	return len(s.Bids) >= s.Players
+}
+
+// Leader return seat left to dealer
+func (BaseRules) Leader(s *State) int {
+	return s.Next(s.Dealer)
+}
+
+// Suit return suit of card
+func (BaseRules) Suit(_ *State, c cards.Card) cards.Suit {
+	return c.Suit
+}
+
+// Power return rank of card, ace is the highest
+func (BaseRules) Power(_ *State, c cards.Card) int {
+	return int(c.Rank)
+}
+
+// ValidatePlay allow any card which follow suit
+func (BaseRules) ValidatePlay(*State, int, cards.Card) error {
+	return nil
+}
+
+// Score return count of tricks won
+func (BaseRules) Score(s *State) []int {
+	return append([]int(nil), s.Won...)
+}
@@ -0,0 +1,66 @@
+package trick
+
+import "github.com/InsideGallery/game-core/cards"
+
+const (
+	spadesBidPoints = 10
+	spadesNilPoints = 100
+)
+
+// SpadesRules rules of individual spades: every seat bids count of tricks once, zero is nil,
+// spades are trump and can not be led until broken, made bid scores ten per trick and one per overtrick
+type SpadesRules struct {
+	BaseRules
+}
+
+// StartHand set spades as trump and start bidding
+func (SpadesRules) StartHand(s *State) bool {
+	s.Trump = cards.Spades
+	return true
+}
+
+// ValidateBid allow bids from nil to count of cards in hand
+func (SpadesRules) ValidateBid(s *State, bid Bid) error {
+	if bid.Pass || bid.Value < 0 || bid.Value > len(s.Hands[bid.Seat]) {
+		return ErrInvalidBid
+	}
+
+	return nil
+}
+
+// ValidatePlay forbid to lead spades until broken, unless there are only spades in hand
+func (r SpadesRules) ValidatePlay(s *State, seat int, c cards.Card) error {
+	if len(s.Current.Plays) > 0 || c.Suit != cards.Spades || s.Played(r, cards.Spades) {
+		return nil
+	}
+
+	for _, h := range s.Hands[seat] {
+		if h.Suit != cards.Spades {
+			return ErrCardNotAllowed
+		}
+	}
+
+	return nil
+}
+
+// Score return points of seats by their bids
+func (SpadesRules) Score(s *State) []int {
+	points := make([]int, s.Players)
+
+	for _, bid := range s.Bids {
+		won := s.Won[bid.Seat]
+
+		switch {
+		case bid.Value == 0 && won == 0:
+			points[bid.Seat] = spadesNilPoints
+		case bid.Value == 0:
+			points[bid.Seat] = -spadesNilPoints
+		case won >= bid.Value:
+			points[bid.Seat] = bid.Value*spadesBidPoints + won - bid.Value
+		default:
+			points[bid.Seat] = -bid.Value * spadesBidPoints
+		}
+	}
+
+	return points
+}
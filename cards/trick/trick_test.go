@@ -0,0 +1,142 @@
+package trick
+
+import (
+	"testing"
+
+	"github.com/InsideGallery/game-core/cards"
+
+	"github.com/InsideGallery/core/testutils"
+)
+
+func hand(names ...string) []cards.Card {
+	result, err := cards.CardsFromInts(cards.GetCardsIDs(names))
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+func card(name string) cards.Card {
+	return hand(name)[0]
+}
+
+// trumpRules rules with fixed trump and no bidding
+type trumpRules struct {
+	BaseRules
+	trump cards.Suit
+}
+
+func (r trumpRules) StartHand(s *State) bool {
+	s.Trump = r.trump
+	return false
+}
+
+func TestTricks(t *testing.T) {
+	g, err := NewGame(3, trumpRules{trump: cards.Hearts})
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, g.Play(0, card("As")), ErrWrongPhase)
+
+	testutils.Equal(t, g.Deal(0, [][]cards.Card{
+		hand("As", "2h"),
+		hand("Ks", "3d"),
+		hand("4c", "Qd"),
+	}), nil)
+	testutils.Equal(t, g.Deal(0, nil), ErrHandInProgress)
+	testutils.Equal(t, g.Phase(), PhasePlaying)
+	testutils.Equal(t, g.Trump(), cards.Hearts)
+
+	// seat left to dealer leads
+	testutils.Equal(t, g.Turn(), 1)
+	testutils.Equal(t, g.Play(0, card("As")), ErrNotYourTurn)
+	testutils.Equal(t, g.Play(1, card("Ah")), ErrCardNotInHand)
+	testutils.Equal(t, g.Play(1, card("3d")), nil)
+	testutils.Equal(t, g.Playable(2), hand("Qd"))
+	testutils.Equal(t, g.Play(2, card("4c")), ErrMustFollowSuit)
+	testutils.Equal(t, g.Play(2, card("Qd")), nil)
+
+	// void in diamonds so trump wins
+	testutils.Equal(t, g.Playable(0), hand("As", "2h"))
+	testutils.Equal(t, g.Play(0, card("2h")), nil)
+	testutils.Equal(t, g.Turn(), 0)
+
+	// off suit card does not win
+	testutils.Equal(t, g.Play(0, card("As")), nil)
+	testutils.Equal(t, g.Play(1, card("Ks")), nil)
+	testutils.Equal(t, g.Play(2, card("4c")), nil)
+
+	testutils.Equal(t, g.Phase(), PhaseFinished)
+	testutils.Equal(t, g.Turn(), -1)
+	testutils.Equal(t, g.Scores(), []int{2, 0, 0})
+
+	s := g.State()
+	testutils.Equal(t, len(s.Tricks), 2)
+	testutils.Equal(t, s.Tricks[0].Leader, 1)
+	testutils.Equal(t, s.Tricks[0].Winner, 0)
+}
+
+func TestSpades(t *testing.T) {
+	g, err := NewGame(2, SpadesRules{})
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, g.Deal(1, [][]cards.Card{
+		hand("2s", "Ah"),
+		hand("Kh", "3h"),
+	}), nil)
+	testutils.Equal(t, g.Phase(), PhaseBidding)
+	testutils.Equal(t, g.Trump(), cards.Spades)
+	testutils.Equal(t, g.Play(0, card("Ah")), ErrWrongPhase)
+	testutils.Equal(t, g.Bid(1, Bid{Value: 1}), ErrNotYourTurn)
+	testutils.Equal(t, g.Bid(0, Bid{Value: 3}), ErrInvalidBid)
+	testutils.Equal(t, g.Bid(0, Bid{Pass: true}), ErrInvalidBid)
+	testutils.Equal(t, g.Bid(0, Bid{Value: 2}), nil)
+	testutils.Equal(t, g.Bid(1, Bid{Value: 0}), nil)
+	testutils.Equal(t, g.Phase(), PhasePlaying)
+
+	// spades are not broken
+	testutils.Equal(t, g.Play(0, card("2s")), ErrCardNotAllowed)
+	testutils.Equal(t, g.Play(0, card("Ah")), nil)
+	testutils.Equal(t, g.Play(1, card("3h")), nil)
+	testutils.Equal(t, g.Play(0, card("2s")), nil)
+	testutils.Equal(t, g.Play(1, card("Kh")), nil)
+
+	// made bid of two, nil bid made
+	testutils.Equal(t, g.Scores(), []int{20, 100})
+}
+
+func TestHearts(t *testing.T) {
+	g, err := NewGame(2, HeartsRules{})
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, g.Deal(0, [][]cards.Card{
+		hand("3c", "Ah", "Qs"),
+		hand("2c", "2h", "Kd"),
+	}), nil)
+
+	// two of clubs leads the first trick
+	testutils.Equal(t, g.Turn(), 1)
+	testutils.Equal(t, g.Play(1, card("Kd")), ErrCardNotAllowed)
+	testutils.Equal(t, g.Play(1, card("2c")), nil)
+	testutils.Equal(t, g.Play(0, card("3c")), nil)
+
+	// hearts are not broken
+	testutils.Equal(t, g.Playable(0), hand("Qs"))
+	testutils.Equal(t, g.Play(0, card("Qs")), nil)
+	testutils.Equal(t, g.Play(1, card("2h")), nil)
+	testutils.Equal(t, g.Play(0, card("Ah")), nil)
+	testutils.Equal(t, g.Play(1, card("Kd")), nil)
+
+	testutils.Equal(t, g.Scores(), []int{15, 0})
+
+	testutils.Equal(t, g.Deal(0, [][]cards.Card{hand("2c")}), ErrInvalidDeal)
+	testutils.Equal(t, g.Deal(5, nil), ErrInvalidSeat)
+
+	// player took all hearts and the queen and shoot the moon
+	moon := Trick{Winner: 2, Plays: []Play{{Card: card("Qs")}}}
+	for _, r := range cards.FrenchRanks {
+		moon.Plays = append(moon.Plays, Play{Card: cards.NewCard(r, cards.Hearts)})
+	}
+
+	testutils.Equal(t, HeartsRules{}.Score(&State{Players: 4, Tricks: []Trick{moon}}), []int{26, 26, 0, 26})
+
+	_, err = NewGame(1, nil)
+	testutils.Equal(t, err, ErrInvalidConfig)
+}
@@ -0,0 +1,332 @@
+package cards
+
+import (
+	"math/bits"
+)
+
+const (
+	handSize = 5
+
+	// lowballBase used to invert scores of lowball hands, greater than any high score
+	lowballBase = royalFlush << (scoreShift + 1)
+	// eightOrBetter highest rank of qualified low hand
+	eightOrBetter = card8
+	// shortDeckWheel ranks of A-6-7-8-9 straight
+	shortDeckWheel = 1<<12 | 1<<4 | 1<<5 | 1<<6 | 1<<7
+	// wheel ranks of A-2-3-4-5 straight
+	wheel = 1<<12 | 1<<0 | 1<<1 | 1<<2 | 1<<3
+)
+
+// Rules describe evaluation of poker variant, higher score is better hand, equal scores are split
+type Rules interface {
+	Evaluate(hole, board []int) int
+}
+
+// HoldemRules best five of hole and board cards, used by Texas Hold'em and Stud
+type HoldemRules struct {
+	Evaluator Evaluator // LookupEvaluation by default
+}
+
+// Evaluate return score of best high hand
+func (h HoldemRules) Evaluate(hole, board []int) int {
+	ev := h.Evaluator
+	if ev == nil {
+		ev = NewLookupEvaluation()
+	}
+
+	cards := make([]int, 0, len(hole)+len(board))
+	cards = append(cards, hole...)
+	cards = append(cards, board...)
+
+	return ev.Evaluate(cards)
+}
+
+// OmahaRules hand made by exactly two hole cards and three board cards
+type OmahaRules struct{}
+
+// Evaluate return score of best high hand, zero if there is not enough cards
+func (OmahaRules) Evaluate(hole, board []int) int {
+	return omahaBest(hole, board, highScore)
+}
+
+// ShortDeckRules six plus hold'em: 36 cards, flush beats full house, A-6-7-8-9 is the lowest straight
+type ShortDeckRules struct{}
+
+// Evaluate return score of best short deck hand
+func (ShortDeckRules) Evaluate(hole, board []int) int {
+	return anyBest(hole, board, shortDeckScore)
+}
+
+// DeuceToSevenRules lowball where aces are high, straights and flushes count against the hand
+type DeuceToSevenRules struct{}
+
+// Evaluate return inverted score, the lowest hand has the highest score
+func (DeuceToSevenRules) Evaluate(hole, board []int) int {
+	return anyBest(hole, board, deuceToSevenScore)
+}
+
+// AceToFiveRules lowball where aces are low, straights and flushes are ignored
+type AceToFiveRules struct{}
+
+// Evaluate return inverted score, the lowest hand has the highest score
+func (AceToFiveRules) Evaluate(hole, board []int) int {
+	return anyBest(hole, board, aceToFiveScore)
+}
+
+// HiLoScore contains high and eight or better low scores
+type HiLoScore struct {
+	High      int
+	Low       int // zero if not qualified
+	Qualified bool
+}
+
+// HiLoRules split pot between the best high and the best qualified ace to five low
+// Omaha rules applied to both halves if Omaha is true, otherwise any five cards used (Stud eight or better)
+type HiLoRules struct {
+	Omaha bool
+}
+
+// Evaluate return high score
+func (r HiLoRules) Evaluate(hole, board []int) int {
+	return r.EvaluateHiLo(hole, board).High
+}
+
+// EvaluateHiLo return high and low scores
+func (r HiLoRules) EvaluateHiLo(hole, board []int) HiLoScore {
+	var res HiLoScore
+
+	if r.Omaha {
+		res.High = omahaBest(hole, board, highScore)
+		res.Low = omahaBest(hole, board, qualifiedLowScore)
+	} else {
+		res.High = anyBest(hole, board, highScore)
+		res.Low = anyBest(hole, board, qualifiedLowScore)
+	}
+
+	res.Qualified = res.Low != 0
+
+	return res
+}
+
+// SplitHiLo split pot between high and qualified low winners, return amount for every score
+// High takes whole pot without qualified low, odd chips go to high half and to winners in order
+func SplitHiLo(pot int, scores []HiLoScore) []int {
+	amounts := make([]int, len(scores))
+	if len(scores) == 0 {
+		return amounts
+	}
+
+	high := bestIndexes(scores, func(s HiLoScore) int { return s.High })
+	low := bestIndexes(scores, func(s HiLoScore) int { return s.Low })
+
+	highPot := pot
+	if scores[low[0]].Qualified {
+		lowPot := pot / 2 //nolint:mnd
+		highPot -= lowPot
+		share(amounts, low, lowPot)
+	}
+
+	share(amounts, high, highPot)
+
+	return amounts
+}
+
+// ShortDeck return 36 cards from six to ace
+func ShortDeck() []int {
+	cards := make([]int, 0, len(deck))
+
+	for _, c := range deck {
+		if c&rank >= card6 {
+			cards = append(cards, c)
+		}
+	}
+
+	return cards
+}
+
+// NewShortDeck return new deck for short deck poker
+func NewShortDeck() *Deck {
+	return NewDeckFromCards(ShortDeck())
+}
+
+func bestIndexes(scores []HiLoScore, score func(HiLoScore) int) []int {
+	var res []int
+
+	best := 0
+
+	for i, s := range scores {
+		switch v := score(s); {
+		case v > best || res == nil:
+			best, res = v, []int{i}
+		case v == best:
+			res = append(res, i)
+		}
+	}
+
+	return res
+}
+
+func share(amounts []int, winners []int, pot int) {
+	part, rest := pot/len(winners), pot%len(winners)
+
+	for i, w := range winners {
+		amounts[w] += part
+		if i < rest {
+			amounts[w]++
+		}
+	}
+}
+
+// anyBest return the best score of any five cards of hole and board
+func anyBest(hole, board []int, score func([]int) int) int {
+	cards := make([]int, 0, len(hole)+len(board))
+	cards = append(cards, hole...)
+	cards = append(cards, board...)
+
+	best := 0
+	hand := make([]int, handSize)
+
+	forEachCombination(cards, hand, 0, 0, func() {
+		best = max(best, score(hand))
+	})
+
+	return best
+}
+
+// omahaBest return the best score of exactly two hole cards and three board cards
+func omahaBest(hole, board []int, score func([]int) int) int {
+	best := 0
+	hand := make([]int, handSize)
+
+	forEachCombination(hole, hand[:2], 0, 0, func() {
+		forEachCombination(board, hand[2:], 0, 0, func() {
+			best = max(best, score(hand))
+		})
+	})
+
+	return best
+}
+
+// forEachCombination fill hand by every combination of cards and call f
+func forEachCombination(cards, hand []int, start, pos int, f func()) {
+	if pos == len(hand) {
+		f()
+		return
+	}
+
+	for i := start; i <= len(cards)-(len(hand)-pos); i++ {
+		hand[pos] = cards[i]
+		forEachCombination(cards, hand, i+1, pos+1, f)
+	}
+}
+
+// highScore return score of five cards with standard high rules
+func highScore(hand []int) int {
+	return NewLookupEvaluation().Evaluate(hand)
+}
+
+// shortDeckScore return score of five cards where flush beats full house and A-6-7-8-9 is straight
+func shortDeckScore(hand []int) int {
+	suits, mask := handMasks(hand)
+	if mask == shortDeckWheel {
+		if bits.OnesCount(uint(suits)) == 1 {
+			return straightFlush<<scoreShift | (rankIndex(card9) + 1)
+		}
+
+		return straight<<scoreShift | (rankIndex(card9) + 1)
+	}
+
+	score := highScore(hand)
+
+	switch score >> scoreShift {
+	case flush:
+		return fullHouse<<scoreShift | score&(1<<scoreShift-1)
+	case fullHouse:
+		return flush<<scoreShift | score&(1<<scoreShift-1)
+	}
+
+	return score
+}
+
+// deuceToSevenScore return inverted high score of five cards, A-2-3-4-5 is not straight
+func deuceToSevenScore(hand []int) int {
+	suits, mask := handMasks(hand)
+	if mask == wheel {
+		category := highCard
+		if bits.OnesCount(uint(suits)) == 1 {
+			category = flush
+		}
+
+		return lowballBase - packRanks(category, 12, 3, 2, 1, 0) //nolint:mnd
+	}
+
+	return lowballBase - highScore(hand)
+}
+
+// handMasks return suits and ranks of cards as bit masks, rank bit is rank index
+func handMasks(hand []int) (int, int) {
+	suits, mask := 0, 0
+	for _, c := range hand {
+		suits |= c & suit
+		mask |= (c & rank) >> 4 //nolint:mnd
+	}
+
+	return suits, mask
+}
+
+// aceToFiveScore return inverted score of five cards where ace is the lowest card, straights and flushes ignored
+func aceToFiveScore(hand []int) int {
+	var counts [ranksCount]uint8
+
+	for _, c := range hand {
+		counts[(rankIndex(c)+1)%ranksCount]++
+	}
+
+	var groups [maxRankCopies + 1][]int
+
+	for r := ranksCount - 1; r >= 0; r-- {
+		if counts[r] != 0 {
+			groups[counts[r]] = append(groups[counts[r]], r)
+		}
+	}
+
+	var ranks []int
+	for size := maxRankCopies; size > 0; size-- {
+		ranks = append(ranks, groups[size]...)
+	}
+
+	var category int
+
+	switch {
+	case len(groups[4]) != 0:
+		category = fourOfAKind
+	case len(groups[3]) != 0 && len(groups[2]) != 0:
+		category = fullHouse
+	case len(groups[3]) != 0:
+		category = threeOfAKind
+	case len(groups[2]) > 1:
+		category = twoPair
+	case len(groups[2]) == 1:
+		category = onePair
+	default:
+		category = highCard
+	}
+
+	return lowballBase - packRanks(category, ranks...)
+}
+
+// qualifiedLowScore return ace to five score of five different cards up to eight, zero if hand is not qualified
+func qualifiedLowScore(hand []int) int {
+	seen := 0
+
+	for _, c := range hand {
+		r := c & rank
+		if (r > eightOrBetter && r != cardA) || seen&r != 0 {
+			return 0
+		}
+
+		seen |= r
+	}
+
+	return aceToFiveScore(hand)
+}
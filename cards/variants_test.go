@@ -0,0 +1,99 @@
+package cards
+
+import (
+	"testing"
+
+	"github.com/InsideGallery/core/testutils"
+)
+
+func ids(names ...string) []int {
+	return GetCardsIDs(names)
+}
+
+func TestOmahaRules(t *testing.T) {
+	r := OmahaRules{}
+	board := ids("Ah", "Kh", "Qh", "2c", "3d")
+
+	// single heart in hole does not make flush
+	oneHeart := r.Evaluate(ids("Jh", "9s", "9c", "4d"), board)
+	testutils.Equal(t, oneHeart>>scoreShift, onePair)
+
+	twoHearts := r.Evaluate(ids("Jh", "Th", "9c", "4d"), board)
+	testutils.Equal(t, twoHearts>>scoreShift, royalFlush)
+
+	// four of a kind on board plays only three cards of it
+	quads := r.Evaluate(ids("Qs", "Qd", "7c", "8c"), ids("Ac", "Ad", "Ah", "As", "Kd"))
+	testutils.Equal(t, quads>>scoreShift, fullHouse)
+
+	testutils.Equal(t, r.Evaluate(ids("As"), board), 0)
+}
+
+func TestShortDeckRules(t *testing.T) {
+	r := ShortDeckRules{}
+	testutils.Equal(t, len(ShortDeck()), 36)
+	testutils.Equal(t, len(NewShortDeck().cards), 36)
+
+	board := ids("Ts", "Tc", "8s", "7s", "Kd")
+	flushHand := r.Evaluate(ids("As", "2s"), board)
+	fullHouseHand := r.Evaluate(ids("Td", "8d"), board)
+	testutils.Equal(t, flushHand > fullHouseHand, true)
+
+	wheel := r.Evaluate(ids("Ah", "6c"), ids("7d", "8s", "9h", "Kc", "Kd"))
+	testutils.Equal(t, wheel>>scoreShift, straight)
+
+	sixHigh := r.Evaluate(ids("6h", "Tc"), ids("7d", "8s", "9h", "Kc", "2d"))
+	testutils.Equal(t, sixHigh > wheel, true)
+
+	trips := r.Evaluate(ids("Kh", "Ks"), ids("7d", "8s", "9h", "Kc", "2d"))
+	testutils.Equal(t, wheel > trips, true)
+}
+
+func TestLowballRules(t *testing.T) {
+	d := DeuceToSevenRules{}
+	number1 := d.Evaluate(ids("7h", "5c", "4d", "3s", "2h"), nil)
+	eightHigh := d.Evaluate(ids("8h", "5c", "4d", "3s", "2h"), nil)
+	straight := d.Evaluate(ids("6h", "5c", "4d", "3s", "2h"), nil)
+	aceHigh := d.Evaluate(ids("Ah", "5c", "4d", "3s", "2h"), nil)
+	testutils.Equal(t, number1 > eightHigh, true)
+	testutils.Equal(t, eightHigh > aceHigh, true)
+	testutils.Equal(t, aceHigh > straight, true)
+
+	a := AceToFiveRules{}
+	wheel := a.Evaluate(ids("Ah", "5h", "4h", "3h", "2h"), nil)
+	sixLow := a.Evaluate(ids("6h", "4c", "3d", "2s", "Ah"), nil)
+	pair := a.Evaluate(ids("Ah", "Ac", "4d", "3s", "2h"), nil)
+	testutils.Equal(t, wheel > sixLow, true)
+	testutils.Equal(t, sixLow > pair, true)
+
+	// best five of seven
+	razz := a.Evaluate(ids("Kh", "Kc", "5d"), ids("4s", "3h", "2c", "Ad"))
+	testutils.Equal(t, razz, wheel)
+}
+
+func TestHiLoRules(t *testing.T) {
+	r := HiLoRules{Omaha: true}
+	board := ids("2h", "5c", "8d", "Ks", "Kd")
+
+	nut := r.EvaluateHiLo(ids("Ah", "3c", "Kc", "Qh"), board)
+	testutils.Equal(t, nut.Qualified, true)
+	testutils.Equal(t, nut.High>>scoreShift, threeOfAKind)
+
+	noLow := r.EvaluateHiLo(ids("Ac", "9s", "Ts", "Qs"), board)
+	testutils.Equal(t, noLow.Qualified, false)
+
+	otherLow := r.EvaluateHiLo(ids("Ad", "4d", "Js", "Jc"), board)
+	testutils.Equal(t, otherLow.Qualified, true)
+	testutils.Equal(t, nut.Low > otherLow.Low, true)
+
+	testutils.Equal(t, SplitHiLo(101, []HiLoScore{nut, noLow, otherLow}), []int{101, 0, 0})
+	testutils.Equal(t, SplitHiLo(101, []HiLoScore{noLow, otherLow}), []int{0, 101})
+
+	flushHigh := HiLoScore{High: nut.High + 1}
+	testutils.Equal(t, SplitHiLo(101, []HiLoScore{flushHigh, otherLow}), []int{51, 50})
+	testutils.Equal(t, SplitHiLo(100, []HiLoScore{flushHigh, noLow}), []int{100, 0})
+	testutils.Equal(t, SplitHiLo(100, []HiLoScore{flushHigh, otherLow, otherLow}), []int{50, 25, 25})
+
+	stud := HiLoRules{}.EvaluateHiLo(ids("Ah", "2c", "9d"), ids("3s", "4h", "5c", "Kd"))
+	testutils.Equal(t, stud.Qualified, true)
+	testutils.Equal(t, stud.High>>scoreShift, straight)
+}
@@ -0,0 +1,196 @@
+package communications
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/InsideGallery/game-core/engine/metrics"
+
+	"github.com/InsideGallery/core/memory/registry"
+	"github.com/InsideGallery/core/testutils"
+)
+
+// recordingMetrics keep last value of every metric by name and labels
+type recordingMetrics struct {
+	counters     map[string]float64
+	gauges       map[string]float64
+	observations map[string]int
+
+	mu sync.Mutex
+}
+
+func newRecordingMetrics() *recordingMetrics {
+	return &recordingMetrics{
+		counters:     map[string]float64{},
+		gauges:       map[string]float64{},
+		observations: map[string]int{},
+	}
+}
+
+func metricKey(name string, labels []metrics.Label) string {
+	parts := []string{name}
+	for _, l := range labels {
+		parts = append(parts, l.Name+"="+l.Value)
+	}
+
+	return strings.Join(parts, ",")
+}
+
+func (m *recordingMetrics) AddCounter(name string, value float64, labels ...metrics.Label) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.counters[metricKey(name, labels)] += value
+}
+
+func (m *recordingMetrics) SetGauge(name string, value float64, labels ...metrics.Label) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.gauges[metricKey(name, labels)] = value
+}
+
+func (m *recordingMetrics) Observe(name string, _ float64, labels ...metrics.Label) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.observations[metricKey(name, labels)]++
+}
+
+type recordedSpan struct {
+	name  string
+	attrs map[string]any
+	err   error
+	ended bool
+}
+
+func (s *recordedSpan) SetAttribute(key string, value any) { s.attrs[key] = value }
+
+func (s *recordedSpan) End(err error) { s.err, s.ended = err, true }
+
+type recordingTracer struct {
+	spans []*recordedSpan
+}
+
+func (t *recordingTracer) StartSpan(ctx context.Context, name string) (context.Context, metrics.Span) {
+	s := &recordedSpan{name: name, attrs: map[string]any{}}
+	t.spans = append(t.spans, s)
+
+	return ctx, s
+}
+
+var (
+	errCommand = errors.New("command failed")
+	errParse   = errors.New("empty message")
+)
+
+type testCommand struct {
+	msgType uint8
+}
+
+func (c *testCommand) GetMsgType() uint8 { return c.msgType }
+
+func (c *testCommand) Decode(_ []byte) {}
+
+func (c *testCommand) Encode() []byte { return []byte{c.msgType} }
+
+func (c *testCommand) Execute(_ context.Context) error {
+	if c.msgType == 2 {
+		return errCommand
+	}
+
+	return nil
+}
+
+type testParser struct{}
+
+func (testParser) Parse(msg []byte) (Command, error) {
+	if len(msg) == 0 {
+		return nil, errParse
+	}
+
+	return &testCommand{msgType: msg[0]}, nil
+}
+
+type testMessage []byte
+
+func (m testMessage) GetMessageType() uint8 { return m[0] }
+
+func (m testMessage) Encode() []byte { return m }
+
+func TestProcessIncomingMessages(t *testing.T) {
+	ctx := context.Background()
+	m, tracer := newRecordingMetrics(), &recordingTracer{}
+
+	c := NewCommunicateComponent(nil)
+	c.SetMetrics(m)
+	c.SetTracer(tracer)
+	c.SetParser(testParser{})
+
+	testutils.Equal(t, c.ProcessIncomingMessages(ctx, []byte{1}), nil)
+	testutils.Equal(t, c.ProcessIncomingMessages(ctx, []byte{2}), errCommand)
+	testutils.Equal(t, c.ProcessIncomingMessages(ctx, nil), errParse)
+
+	testutils.Equal(t, m.counters, map[string]float64{
+		MetricCommandErrors + ",type=2":       1,
+		MetricCommandErrors + ",type=unknown": 1,
+	})
+	testutils.Equal(t, m.observations, map[string]int{
+		MetricCommandDuration + ",type=1": 1,
+		MetricCommandDuration + ",type=2": 1,
+	})
+	testutils.Equal(t, len(m.gauges), 0)
+
+	testutils.Equal(t, len(tracer.spans), 2)
+	testutils.Equal(t, tracer.spans[0].name, SpanCommandExecute)
+	testutils.Equal(t, tracer.spans[0].attrs, map[string]any{AttributeCommandType: "1"})
+	testutils.Equal(t, [2]any{tracer.spans[0].ended, tracer.spans[0].err}, [2]any{true, nil})
+	testutils.Equal(t, [2]any{tracer.spans[1].ended, tracer.spans[1].err}, [2]any{true, errCommand})
+}
+
+func TestProcessOutgoingQueue(t *testing.T) {
+	m := newRecordingMetrics()
+
+	c := NewCommunicateComponent(nil)
+	c.SetMetrics(m)
+	c.AddMessageToQueue(testMessage{1})
+	c.AddMessageToQueue(testMessage{2})
+	c.ProcessOutgoingQueue()
+
+	testutils.Equal(t, len(c.GetOutgoing()), 2)
+	testutils.Equal(t, m.counters, map[string]float64{MetricMessagesQueued: 2, MetricMessagesSent: 2})
+	testutils.Equal(t, c.OutgoingQueueLen(), 0)
+	testutils.Equal(t, len(m.gauges), 0)
+}
+
+func TestCommunicationSystemUpdate(t *testing.T) {
+	m := newRecordingMetrics()
+	reg := registry.NewRegistry[any, any, any]()
+
+	// every component has own queue depth, gauges show the sum over all of them
+	for i := range 3 {
+		c := NewCommunicateComponent(nil)
+		c.SetMetrics(m)
+
+		for j := range i + 1 {
+			c.AddMessageToQueue(testMessage{byte(j)})
+			c.GetIncoming() <- []byte{byte(j)}
+		}
+
+		testutils.Equal(t, c.OutgoingQueueLen(), i+1)
+		testutils.Equal(t, reg.Add("players", i, c), nil)
+	}
+
+	s := NewCommunicationSystem(reg, 2, "players")
+	s.SetMetrics(m)
+	testutils.Equal(t, s.Update(context.Background()), nil)
+
+	testutils.Equal(t, m.gauges[MetricSystemEntities], 3.0)
+	testutils.Equal(t, m.observations[MetricSystemUpdateDuration], 1)
+	testutils.Equal(t, m.counters[MetricMessagesSent], 6.0)
+	testutils.Equal(t, m.gauges[MetricOutgoingQueueDepth], 6.0)
+	testutils.Equal(t, m.gauges[MetricIncomingQueueDepth], 6.0)
+}
@@ -4,11 +4,26 @@ import (
 	"context"
 	"log"
 	"net"
+	"strconv"
 	"sync"
+	"time"
+
+	"github.com/InsideGallery/game-core/engine/metrics"
 )
 
 const bufferSize = 1000
 
+// Metrics of communication component
+const (
+	MetricMessagesQueued  = "communication_messages_queued_total"
+	MetricMessagesSent    = "communication_messages_sent_total"
+	MetricCommandDuration = "command_execution_seconds"
+	MetricCommandErrors   = "command_errors_total"
+	SpanCommandExecute    = "command.execute"
+	AttributeCommandType  = "command.type"
+	LabelCommandType      = "type"
+)
+
 // CommunicateComponent communication component
 type CommunicateComponent struct {
 	conn          net.Conn
@@ -17,6 +32,8 @@ type CommunicateComponent struct {
 	outgoingQueue [][]byte
 	parser        CommandParser
 	wait          bool
+	metrics       metrics.Metrics
+	tracer        metrics.Tracer
 
 	mu sync.RWMutex
 }
@@ -93,6 +110,8 @@ func (c *CommunicateComponent) Write(d []byte) {
 		return
 	}
 	c.outgoing <- d
+
+	c.GetMetrics().AddCounter(MetricMessagesSent, 1)
 }
 
 // GetIncoming return incoming channel
@@ -100,11 +119,57 @@ func (c *CommunicateComponent) GetIncoming() chan []byte {
 	return c.incoming
 }
 
+// SetMetrics set metrics, metrics.Default used if not set
+func (c *CommunicateComponent) SetMetrics(m metrics.Metrics) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.metrics = m
+}
+
+// GetMetrics return metrics
+func (c *CommunicateComponent) GetMetrics() metrics.Metrics {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.metrics == nil {
+		return metrics.Default()
+	}
+
+	m := c.metrics
+
+	return m
+}
+
+// SetTracer set tracer for command spans, metrics.DefaultTracer used if not set
+func (c *CommunicateComponent) SetTracer(t metrics.Tracer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.tracer = t
+}
+
+// GetTracer return tracer
+func (c *CommunicateComponent) GetTracer() metrics.Tracer {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.tracer == nil {
+		return metrics.DefaultTracer()
+	}
+
+	t := c.tracer
+
+	return t
+}
+
 // AddMessageToQueue add message to queue
 func (c *CommunicateComponent) AddMessageToQueue(m OutgoingMessage) {
 	c.mu.Lock()
 	c.outgoingQueue = append(c.outgoingQueue, m.Encode())
 	c.mu.Unlock()
+
+	c.GetMetrics().AddCounter(MetricMessagesQueued, 1)
 }
 
 // GetQueue retun copy of queue
@@ -119,6 +184,16 @@ func (c *CommunicateComponent) GetQueue() [][]byte {
 	return d
 }
 
+// OutgoingQueueLen return count of messages waiting in queue
+func (c *CommunicateComponent) OutgoingQueueLen() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	n := len(c.outgoingQueue)
+
+	return n
+}
+
 // ProcessOutgoingQueue processing cache
 func (c *CommunicateComponent) ProcessOutgoingQueue() {
 	defer func() {
@@ -158,12 +233,30 @@ func (c *CommunicateComponent) ProcessIncomingMessages(ctx context.Context, e []
 		return nil
 	}
 
+	m := c.GetMetrics()
+
 	cmd, err := c.GetParser().Parse(e)
 	if err != nil {
+		m.AddCounter(MetricCommandErrors, 1, metrics.L(LabelCommandType, "unknown"))
 		return err
 	}
 
-	return cmd.Execute(ctx)
+	msgType := strconv.Itoa(int(cmd.GetMsgType()))
+
+	ctx, span := c.GetTracer().StartSpan(ctx, SpanCommandExecute)
+	span.SetAttribute(AttributeCommandType, msgType)
+
+	start := time.Now()
+	err = cmd.Execute(ctx)
+
+	m.Observe(MetricCommandDuration, time.Since(start).Seconds(), metrics.L(LabelCommandType, msgType))
+	span.End(err)
+
+	if err != nil {
+		m.AddCounter(MetricCommandErrors, 1, metrics.L(LabelCommandType, msgType))
+	}
+
+	return err
 }
 
 // StartReadingMessages starting processing incoming messages
@@ -192,4 +285,6 @@ func (c *CommunicateComponent) Send(d OutgoingMessage) {
 	}()
 
 	c.GetOutgoing() <- d.Encode()
+
+	c.GetMetrics().AddCounter(MetricMessagesSent, 1)
 }
@@ -2,11 +2,23 @@ package communications
 
 import (
 	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/InsideGallery/game-core/engine/metrics"
 
 	"github.com/InsideGallery/core/memory/registry"
 	"github.com/InsideGallery/core/multiproc/worker"
 )
 
+// Metrics of communication system
+const (
+	MetricSystemUpdateDuration = "communication_system_update_seconds"
+	MetricSystemEntities       = "communication_system_entities"
+	MetricOutgoingQueueDepth   = "communication_outgoing_queue_depth"
+	MetricIncomingQueueDepth   = "communication_incoming_queue_depth"
+)
+
 // OutgoingMessage describe outgoing message
 type OutgoingMessage interface {
 	GetMessageType() uint8
@@ -24,11 +36,17 @@ type Communication interface {
 	Write(d []byte)
 }
 
+// OutgoingQueue describe communication which report length of outgoing queue
+type OutgoingQueue interface {
+	OutgoingQueueLen() int
+}
+
 // CommunicationSystem contains moveable entities
 type CommunicationSystem struct {
 	keys         []interface{}
 	workersCount int
 	reg          *registry.Registry[any, any, any]
+	metrics      metrics.Metrics
 }
 
 // NewCommunicationSystem return new CommunicationSystem
@@ -44,13 +62,30 @@ func NewCommunicationSystem(
 	}
 }
 
+// SetMetrics set metrics, metrics.Default used if not set
+func (c *CommunicationSystem) SetMetrics(m metrics.Metrics) {
+	c.metrics = m
+}
+
+// GetMetrics return metrics
+func (c *CommunicationSystem) GetMetrics() metrics.Metrics {
+	if c.metrics == nil {
+		return metrics.Default()
+	}
+
+	return c.metrics
+}
+
 // EntitiesKeys return entities keys
 func (c *CommunicationSystem) EntitiesKeys() []interface{} {
 	return c.keys
 }
 
-// Update update move
+// Update process outgoing queues, queue depths are reported summed over all entities
 func (c *CommunicationSystem) Update(ctx context.Context) error {
+	start := time.Now()
+	var count, incoming, outgoing int64
+
 	for _, key := range c.EntitiesKeys() {
 		g := c.reg.GetGroup(key).Iterator()
 
@@ -61,10 +96,22 @@ func (c *CommunicationSystem) Update(ctx context.Context) error {
 					continue
 				}
 
+				if q, ok := m.(OutgoingQueue); ok {
+					atomic.AddInt64(&outgoing, int64(q.OutgoingQueueLen()))
+				}
+
+				atomic.AddInt64(&incoming, int64(len(m.GetIncoming())))
 				m.ProcessOutgoingQueue()
+				atomic.AddInt64(&count, 1)
 			}
 		})
 	}
 
+	m := c.GetMetrics()
+	m.Observe(MetricSystemUpdateDuration, time.Since(start).Seconds())
+	m.SetGauge(MetricSystemEntities, float64(atomic.LoadInt64(&count)))
+	m.SetGauge(MetricOutgoingQueueDepth, float64(atomic.LoadInt64(&outgoing)))
+	m.SetGauge(MetricIncomingQueueDepth, float64(atomic.LoadInt64(&incoming)))
+
 	return nil
 }
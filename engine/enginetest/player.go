@@ -0,0 +1,21 @@
+package enginetest
+
+import (
+	"github.com/InsideGallery/game-core/engine/communications"
+
+	"github.com/InsideGallery/core/ecs"
+)
+
+// Player entity with communication component for tests, messages are kept in queue
+type Player struct {
+	*ecs.BaseEntity
+	*communications.CommunicateComponent
+}
+
+// NewPlayer return player with given id and without connection
+func NewPlayer(id uint64) *Player {
+	return &Player{
+		BaseEntity:           ecs.NewBaseEntityWithID(id),
+		CommunicateComponent: communications.NewCommunicateComponent(nil),
+	}
+}
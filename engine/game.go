@@ -19,3 +19,8 @@ type Player interface {
 	ecs.Entity
 	communications.Communication
 }
+
+// Checksummer describe object which is able to hash own state
+type Checksummer interface {
+	Checksum() uint64
+}
@@ -0,0 +1,52 @@
+package engine
+
+import (
+	"context"
+	"time"
+
+	"github.com/InsideGallery/game-core/engine/metrics"
+)
+
+// Metrics of game loop
+const (
+	MetricTickDuration = "game_tick_seconds"
+	MetricTicks        = "game_ticks_total"
+	SpanTick           = "game.tick"
+)
+
+// InstrumentedGame report duration of game ticks
+type InstrumentedGame struct {
+	Game
+	metrics metrics.Metrics
+	tracer  metrics.Tracer
+}
+
+// NewInstrumentedGame return game which report metrics of given game, defaults used for nil metrics or tracer
+func NewInstrumentedGame(game Game, m metrics.Metrics, t metrics.Tracer) *InstrumentedGame {
+	return &InstrumentedGame{
+		Game:    game,
+		metrics: m,
+		tracer:  t,
+	}
+}
+
+// Tick tick game and report duration
+func (g *InstrumentedGame) Tick(ctx context.Context) {
+	m, t := g.metrics, g.tracer
+	if m == nil {
+		m = metrics.Default()
+	}
+
+	if t == nil {
+		t = metrics.DefaultTracer()
+	}
+
+	ctx, span := t.StartSpan(ctx, SpanTick)
+	start := time.Now()
+
+	g.Game.Tick(ctx)
+
+	m.Observe(MetricTickDuration, time.Since(start).Seconds())
+	m.AddCounter(MetricTicks, 1)
+	span.End(nil)
+}
@@ -0,0 +1,68 @@
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/InsideGallery/game-core/engine/metrics"
+
+	"github.com/InsideGallery/core/testutils"
+)
+
+type countingGame struct {
+	ticks int
+}
+
+func (g *countingGame) Initialize() error { return nil }
+
+func (g *countingGame) Tick(_ context.Context) { g.ticks++ }
+
+// recordingMetrics count calls of every metric
+type recordingMetrics struct {
+	counters     map[string]float64
+	observations map[string]int
+}
+
+func (m *recordingMetrics) AddCounter(name string, value float64, _ ...metrics.Label) {
+	m.counters[name] += value
+}
+
+func (m *recordingMetrics) SetGauge(string, float64, ...metrics.Label) {}
+
+func (m *recordingMetrics) Observe(name string, _ float64, _ ...metrics.Label) {
+	m.observations[name]++
+}
+
+type recordingTracer struct {
+	started []string
+	ended   int
+}
+
+func (t *recordingTracer) StartSpan(ctx context.Context, name string) (context.Context, metrics.Span) {
+	t.started = append(t.started, name)
+	return ctx, t
+}
+
+func (t *recordingTracer) SetAttribute(string, any) {}
+
+func (t *recordingTracer) End(error) { t.ended++ }
+
+func TestInstrumentedGame(t *testing.T) {
+	game := &countingGame{}
+	m := &recordingMetrics{counters: map[string]float64{}, observations: map[string]int{}}
+	tracer := &recordingTracer{}
+
+	g := NewInstrumentedGame(game, m, tracer)
+	g.Tick(context.Background())
+	g.Tick(context.Background())
+
+	testutils.Equal(t, game.ticks, 2)
+	testutils.Equal(t, m.counters, map[string]float64{MetricTicks: 2})
+	testutils.Equal(t, m.observations, map[string]int{MetricTickDuration: 2})
+	testutils.Equal(t, tracer.started, []string{SpanTick, SpanTick})
+	testutils.Equal(t, tracer.ended, 2)
+
+	// defaults used without metrics and tracer
+	NewInstrumentedGame(game, nil, nil).Tick(context.Background())
+	testutils.Equal(t, game.ticks, 3)
+}
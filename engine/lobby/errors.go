@@ -0,0 +1,16 @@
+package lobby
+
+import "errors"
+
+// All kind of errors for lobby
+var (
+	ErrLobbyNotFound   = errors.New("lobby not found")
+	ErrLobbyFull       = errors.New("lobby is full")
+	ErrLobbyStarted    = errors.New("lobby already started")
+	ErrAlreadyInLobby  = errors.New("player already in lobby")
+	ErrNotInLobby      = errors.New("player not in lobby")
+	ErrAlreadyQueued   = errors.New("player already queued")
+	ErrNotQueued       = errors.New("player not queued")
+	ErrInvalidTeamSize = errors.New("invalid teams configuration")
+	ErrMalformedEvent  = errors.New("malformed lobby event")
+)
@@ -0,0 +1,113 @@
+package lobby
+
+import "encoding/binary"
+
+// EventType describe type of lobby event
+type EventType uint8
+
+// Lobby event types
+const (
+	EventLobbyCreated EventType = iota + 1
+	EventPlayerJoined
+	EventPlayerLeft
+	EventPlayerReady
+	EventPlayerNotReady
+	EventOwnerChanged
+	EventMatchFormed
+)
+
+// Event describe lobby or matchmaking notification
+type Event struct {
+	MsgType  uint8
+	Type     EventType
+	LobbyID  uint64
+	MatchID  uint64 // only for EventMatchFormed
+	PlayerID uint64
+	Teams    [][]uint64 // player ids by team, only for EventMatchFormed
+}
+
+// GetMessageType return message type
+func (e *Event) GetMessageType() uint8 {
+	return e.MsgType
+}
+
+// Encode encode event as: type | event type | lobby id | match id | player id | teams count | (players count | player id...)...
+func (e *Event) Encode() []byte {
+	buf := []byte{e.MsgType, byte(e.Type)}
+	buf = binary.AppendUvarint(buf, e.LobbyID)
+	buf = binary.AppendUvarint(buf, e.MatchID)
+	buf = binary.AppendUvarint(buf, e.PlayerID)
+	buf = binary.AppendUvarint(buf, uint64(len(e.Teams)))
+
+	for _, team := range e.Teams {
+		buf = binary.AppendUvarint(buf, uint64(len(team)))
+		for _, id := range team {
+			buf = binary.AppendUvarint(buf, id)
+		}
+	}
+
+	return buf
+}
+
+// DecodeEvent decode event encoded by Encode
+func DecodeEvent(data []byte) (*Event, error) {
+	if len(data) < 2 { //nolint:mnd
+		return nil, ErrMalformedEvent
+	}
+
+	e := &Event{MsgType: data[0], Type: EventType(data[1])}
+	data = data[2:]
+
+	var err error
+
+	for _, v := range []*uint64{&e.LobbyID, &e.MatchID, &e.PlayerID} {
+		*v, data, err = readUvarint(data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	teams, data, err := readUvarint(data)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := uint64(0); i < teams; i++ {
+		var count uint64
+
+		count, data, err = readUvarint(data)
+		if err != nil {
+			return nil, err
+		}
+
+		team := []uint64{}
+
+		for j := uint64(0); j < count; j++ {
+			var id uint64
+
+			id, data, err = readUvarint(data)
+			if err != nil {
+				return nil, err
+			}
+
+			team = append(team, id)
+		}
+
+		e.Teams = append(e.Teams, team)
+	}
+
+	if len(data) != 0 {
+		return nil, ErrMalformedEvent
+	}
+
+	return e, nil
+}
+
+func readUvarint(data []byte) (uint64, []byte, error) {
+	v, n := binary.Uvarint(data)
+	if n <= 0 {
+		return 0, nil, ErrMalformedEvent
+	}
+
+	return v, data[n:], nil
+}
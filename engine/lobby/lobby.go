@@ -0,0 +1,312 @@
+package lobby
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/InsideGallery/game-core/engine"
+)
+
+// Lobby describe group of players waiting for the game
+type Lobby struct {
+	ID         uint64
+	OwnerID    uint64
+	MaxPlayers int
+	MinPlayers int
+	Teams      int
+	Started    bool
+
+	members []Ticket
+	ready   map[uint64]bool
+}
+
+// Members return copy of lobby members
+func (l *Lobby) Members() []Ticket {
+	members := make([]Ticket, len(l.members))
+	copy(members, l.members)
+
+	return members
+}
+
+// IsReady return true if player is ready
+func (l *Lobby) IsReady(playerID uint64) bool {
+	return l.ready[playerID]
+}
+
+// snapshot return copy of lobby
+func (l *Lobby) snapshot() *Lobby {
+	c := *l
+	c.members = l.Members()
+	c.ready = make(map[uint64]bool, len(l.ready))
+
+	for k, v := range l.ready {
+		c.ready[k] = v
+	}
+
+	return &c
+}
+
+func (l *Lobby) index(playerID uint64) int {
+	for i, m := range l.members {
+		if m.Player.GetID() == playerID {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (l *Lobby) allReady() bool {
+	if len(l.members) < l.MinPlayers {
+		return false
+	}
+
+	for _, m := range l.members {
+		if !l.ready[m.Player.GetID()] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Service manage lobbies
+type Service struct {
+	lobbies map[uint64]*Lobby
+	players map[uint64]uint64 // player id -> lobby id
+	msgType uint8
+	aid     uint64
+	onMatch func(m *Match)
+
+	mu sync.Mutex
+}
+
+// NewService return new lobby service, events sent to players with given message type
+func NewService(msgType uint8) *Service {
+	return &Service{
+		lobbies: make(map[uint64]*Lobby),
+		players: make(map[uint64]uint64),
+		msgType: msgType,
+	}
+}
+
+// OnMatch set callback called when all players of lobby are ready
+func (s *Service) OnMatch(f func(m *Match)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.onMatch = f
+}
+
+// Create create lobby owned by given player
+func (s *Service) Create(owner engine.Player, rating float64, minPlayers, maxPlayers, teams int) (*Lobby, error) {
+	if teams <= 0 || maxPlayers < minPlayers || minPlayers < teams {
+		return nil, ErrInvalidTeamSize
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.players[owner.GetID()]; exists {
+		return nil, ErrAlreadyInLobby
+	}
+
+	l := &Lobby{
+		ID:         atomic.AddUint64(&s.aid, 1),
+		OwnerID:    owner.GetID(),
+		MaxPlayers: maxPlayers,
+		MinPlayers: minPlayers,
+		Teams:      teams,
+		members:    []Ticket{{Player: owner, Rating: rating}},
+		ready:      make(map[uint64]bool),
+	}
+
+	s.lobbies[l.ID] = l
+	s.players[owner.GetID()] = l.ID
+	s.notify(l, EventLobbyCreated, owner.GetID())
+
+	return l.snapshot(), nil
+}
+
+// Get return snapshot of lobby by id
+func (s *Service) Get(lobbyID uint64) (*Lobby, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l, exists := s.lobbies[lobbyID]
+	if !exists {
+		return nil, ErrLobbyNotFound
+	}
+
+	return l.snapshot(), nil
+}
+
+// LobbyOf return lobby id of player
+func (s *Service) LobbyOf(playerID uint64) (uint64, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, exists := s.players[playerID]
+
+	return id, exists
+}
+
+// Join add player to lobby
+func (s *Service) Join(lobbyID uint64, p engine.Player, rating float64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l, exists := s.lobbies[lobbyID]
+	if !exists {
+		return ErrLobbyNotFound
+	}
+
+	if _, exists := s.players[p.GetID()]; exists {
+		return ErrAlreadyInLobby
+	}
+
+	if l.Started {
+		return ErrLobbyStarted
+	}
+
+	if len(l.members) >= l.MaxPlayers {
+		return ErrLobbyFull
+	}
+
+	l.members = append(l.members, Ticket{Player: p, Rating: rating})
+	s.players[p.GetID()] = lobbyID
+	s.notify(l, EventPlayerJoined, p.GetID())
+
+	return nil
+}
+
+// Leave remove player from lobby, ownership passed to next member, empty lobby removed
+func (s *Service) Leave(playerID uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	lobbyID, exists := s.players[playerID]
+	if !exists {
+		return ErrNotInLobby
+	}
+
+	l := s.lobbies[lobbyID]
+	i := l.index(playerID)
+
+	s.notify(l, EventPlayerLeft, playerID)
+
+	l.members = append(l.members[:i], l.members[i+1:]...)
+	delete(l.ready, playerID)
+	delete(s.players, playerID)
+
+	if len(l.members) == 0 {
+		delete(s.lobbies, lobbyID)
+		return nil
+	}
+
+	if l.OwnerID == playerID {
+		l.OwnerID = l.members[0].Player.GetID()
+		s.notify(l, EventOwnerChanged, l.OwnerID)
+	}
+
+	return nil
+}
+
+// SetReady mark player ready, match formed when all players are ready
+func (s *Service) SetReady(playerID uint64, ready bool) error {
+	s.mu.Lock()
+
+	lobbyID, exists := s.players[playerID]
+	if !exists {
+		s.mu.Unlock()
+		return ErrNotInLobby
+	}
+
+	l := s.lobbies[lobbyID]
+	if l.Started {
+		s.mu.Unlock()
+		return ErrLobbyStarted
+	}
+
+	l.ready[playerID] = ready
+
+	if !ready {
+		s.notify(l, EventPlayerNotReady, playerID)
+		s.mu.Unlock()
+
+		return nil
+	}
+
+	s.notify(l, EventPlayerReady, playerID)
+
+	if !l.allReady() {
+		s.mu.Unlock()
+		return nil
+	}
+
+	l.Started = true
+	m := &Match{
+		ID:      nextMatchID(),
+		LobbyID: l.ID,
+		Teams:   BalanceTeams(l.members, l.Teams),
+	}
+	notifyMatch(m, s.msgType)
+
+	onMatch := s.onMatch
+	s.mu.Unlock()
+
+	if onMatch != nil {
+		onMatch(m)
+	}
+
+	return nil
+}
+
+// Close remove lobby and release its players
+func (s *Service) Close(lobbyID uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l, exists := s.lobbies[lobbyID]
+	if !exists {
+		return ErrLobbyNotFound
+	}
+
+	for _, m := range l.members {
+		delete(s.players, m.Player.GetID())
+	}
+
+	delete(s.lobbies, lobbyID)
+
+	return nil
+}
+
+// notify send event to all lobby members
+func (s *Service) notify(l *Lobby, t EventType, playerID uint64) {
+	e := &Event{
+		MsgType:  s.msgType,
+		Type:     t,
+		LobbyID:  l.ID,
+		PlayerID: playerID,
+	}
+
+	for _, m := range l.members {
+		m.Player.AddMessageToQueue(e)
+	}
+}
+
+// notifyMatch send match formed event to all players of match
+func notifyMatch(m *Match, msgType uint8) {
+	teams := m.TeamIDs()
+
+	for _, p := range m.Players() {
+		p.AddMessageToQueue(&Event{
+			MsgType:  msgType,
+			Type:     EventMatchFormed,
+			LobbyID:  m.LobbyID,
+			MatchID:  m.ID,
+			PlayerID: p.GetID(),
+			Teams:    teams,
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package lobby
+
+import (
+	"testing"
+	"time"
+
+	"github.com/InsideGallery/game-core/engine/enginetest"
+
+	"github.com/InsideGallery/core/testutils"
+)
+
+func TestLobby(t *testing.T) {
+	s := NewService(3)
+	p1, p2, p3 := enginetest.NewPlayer(1), enginetest.NewPlayer(2), enginetest.NewPlayer(3)
+
+	l, err := s.Create(p1, 1000, 2, 2, 2)
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, s.Join(l.ID, p1, 1000), ErrAlreadyInLobby)
+	testutils.Equal(t, s.Join(l.ID, p2, 1200), nil)
+	testutils.Equal(t, s.Join(l.ID, p3, 1200), ErrLobbyFull)
+	testutils.Equal(t, s.Join(100, p3, 1200), ErrLobbyNotFound)
+
+	testutils.Equal(t, s.Leave(1), nil)
+	l, err = s.Get(l.ID)
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, l.OwnerID, uint64(2))
+	testutils.Equal(t, s.Join(l.ID, p3, 900), nil)
+
+	var formed *Match
+	s.OnMatch(func(m *Match) { formed = m })
+
+	testutils.Equal(t, s.SetReady(2, true), nil)
+	testutils.Equal(t, s.SetReady(1, true), ErrNotInLobby)
+	testutils.Equal(t, formed == nil, true)
+	testutils.Equal(t, s.SetReady(3, true), nil)
+	testutils.Equal(t, formed.TeamIDs(), [][]uint64{{2}, {3}})
+	testutils.Equal(t, formed.LobbyID, l.ID)
+	testutils.Equal(t, s.Join(l.ID, p1, 1000), ErrLobbyStarted)
+
+	queue := p3.GetQueue()
+	testutils.Equal(t, len(queue), 4) // joined, ready, ready, match
+
+	e, err := DecodeEvent(queue[3])
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, *e, Event{
+		MsgType:  3,
+		Type:     EventMatchFormed,
+		LobbyID:  l.ID,
+		MatchID:  formed.ID,
+		PlayerID: 3,
+		Teams:    [][]uint64{{2}, {3}},
+	})
+
+	_, err = DecodeEvent(queue[3][:len(queue[3])-1])
+	testutils.Equal(t, err, ErrMalformedEvent)
+
+	testutils.Equal(t, s.Close(l.ID), nil)
+	_, exists := s.LobbyOf(2)
+	testutils.Equal(t, exists, false)
+}
+
+func TestMatchmaker(t *testing.T) {
+	now := time.Now()
+	m, err := NewMatchmaker(MatchmakerConfig{
+		Teams:         2,
+		TeamSize:      2,
+		InitialWindow: 100,
+		WindowGrowth:  10,
+		MaxWindow:     500,
+	})
+	testutils.Equal(t, err, nil)
+
+	ratings := []float64{1000, 1050, 1300, 1090, 1500}
+	for i, r := range ratings {
+		testutils.Equal(t, m.Enqueue(enginetest.NewPlayer(uint64(i+1)), r, now), nil)
+	}
+	testutils.Equal(t, m.Enqueue(enginetest.NewPlayer(1), 1000, now), ErrAlreadyQueued)
+
+	testutils.Equal(t, len(m.Match(now)), 0)
+
+	// after 30 seconds window is 400, 1300 is reachable from 1000
+	matches := m.Match(now.Add(30 * time.Second))
+	testutils.Equal(t, len(matches), 1)
+	testutils.Equal(t, m.Size(), 1)
+
+	teams := matches[0].Teams
+	testutils.Equal(t, matches[0].TeamIDs(), [][]uint64{{3, 1}, {4, 2}})
+	testutils.Equal(t, TeamRating(teams[0]), 2300.0)
+	testutils.Equal(t, TeamRating(teams[1]), 2140.0)
+
+	// matchmaker match has no lobby, only match id
+	e, err := DecodeEvent(matches[0].Teams[0][0].Player.(*enginetest.Player).GetQueue()[0])
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, e.Type, EventMatchFormed)
+	testutils.Equal(t, e.LobbyID, uint64(0))
+	testutils.Equal(t, e.MatchID, matches[0].ID)
+
+	testutils.Equal(t, m.Dequeue(5), nil)
+	testutils.Equal(t, m.Dequeue(5), ErrNotQueued)
+
+	// lobby and matchmaker take match ids from one source
+	s := NewService(2)
+	l, err := s.Create(enginetest.NewPlayer(10), 1000, 1, 1, 1)
+	testutils.Equal(t, err, nil)
+
+	var formed *Match
+	s.OnMatch(func(m *Match) { formed = m })
+	testutils.Equal(t, s.SetReady(10, true), nil)
+	testutils.Equal(t, formed.LobbyID, l.ID)
+	testutils.Equal(t, formed.ID > matches[0].ID, true)
+}
+
+func TestMatchmakerPairwiseWindow(t *testing.T) {
+	now := time.Now()
+	m, err := NewMatchmaker(MatchmakerConfig{Teams: 1, TeamSize: 3, InitialWindow: 100})
+	testutils.Equal(t, err, nil)
+
+	// 910 and 1090 are in window of 1000 but not in windows of each other
+	for i, r := range []float64{1000, 910, 1090} {
+		testutils.Equal(t, m.Enqueue(enginetest.NewPlayer(uint64(i+1)), r, now), nil)
+	}
+
+	testutils.Equal(t, len(m.Match(now)), 0)
+
+	testutils.Equal(t, m.Enqueue(enginetest.NewPlayer(4), 950, now), nil)
+	matches := m.Match(now)
+	testutils.Equal(t, len(matches), 1)
+	testutils.Equal(t, matches[0].TeamIDs(), [][]uint64{{1, 4, 2}})
+}
+
+func TestBalanceTeams(t *testing.T) {
+	var tickets []Ticket
+	for i, r := range []float64{10, 9, 8, 7, 6, 5} {
+		tickets = append(tickets, Ticket{Player: enginetest.NewPlayer(uint64(i + 1)), Rating: r})
+	}
+
+	teams := BalanceTeams(tickets, 2)
+	testutils.Equal(t, len(teams[0]), 3)
+	testutils.Equal(t, len(teams[1]), 3)
+	testutils.Equal(t, TeamRating(teams[0]), 23.0)
+	testutils.Equal(t, TeamRating(teams[1]), 22.0)
+}
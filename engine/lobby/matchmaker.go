@@ -0,0 +1,220 @@
+package lobby
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/InsideGallery/game-core/engine"
+)
+
+// MatchmakerConfig describe matchmaker rules
+type MatchmakerConfig struct {
+	Teams         int
+	TeamSize      int
+	InitialWindow float64 // allowed rating difference right after enqueue
+	WindowGrowth  float64 // window growth per second of waiting
+	MaxWindow     float64 // maximum window, 0 - unlimited
+	MsgType       uint8
+}
+
+// Matchmaker group queued players into matches by rating
+type Matchmaker struct {
+	config  MatchmakerConfig
+	queue   []Ticket
+	onMatch func(m *Match)
+
+	mu sync.Mutex
+}
+
+// NewMatchmaker return new matchmaker
+func NewMatchmaker(config MatchmakerConfig) (*Matchmaker, error) {
+	if config.Teams <= 0 || config.TeamSize <= 0 {
+		return nil, ErrInvalidTeamSize
+	}
+
+	return &Matchmaker{
+		config: config,
+	}, nil
+}
+
+// OnMatch set callback called for each formed match
+func (m *Matchmaker) OnMatch(f func(m *Match)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.onMatch = f
+}
+
+// Enqueue add player to queue
+func (m *Matchmaker) Enqueue(p engine.Player, rating float64, now time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.index(p.GetID()) != -1 {
+		return ErrAlreadyQueued
+	}
+
+	m.queue = append(m.queue, Ticket{
+		Player:   p,
+		Rating:   rating,
+		Enqueued: now,
+	})
+
+	return nil
+}
+
+// Dequeue remove player from queue
+func (m *Matchmaker) Dequeue(playerID uint64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	i := m.index(playerID)
+	if i == -1 {
+		return ErrNotQueued
+	}
+
+	m.queue = append(m.queue[:i], m.queue[i+1:]...)
+
+	return nil
+}
+
+// Size return count of queued players
+func (m *Matchmaker) Size() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return len(m.queue)
+}
+
+// Window return allowed rating difference for ticket at given time
+func (m *Matchmaker) Window(t Ticket, now time.Time) float64 {
+	w := m.config.InitialWindow + m.config.WindowGrowth*now.Sub(t.Enqueued).Seconds()
+	if m.config.MaxWindow > 0 {
+		w = math.Min(w, m.config.MaxWindow)
+	}
+
+	return w
+}
+
+// Match form as many matches as possible, longest waiting players matched first
+func (m *Matchmaker) Match(now time.Time) []*Match {
+	m.mu.Lock()
+
+	size := m.config.Teams * m.config.TeamSize
+	var matches []*Match
+
+	for {
+		group := m.findGroup(now, size)
+		if group == nil {
+			break
+		}
+
+		for _, t := range group {
+			i := m.index(t.Player.GetID())
+			m.queue = append(m.queue[:i], m.queue[i+1:]...)
+		}
+
+		match := &Match{
+			ID:    nextMatchID(),
+			Teams: BalanceTeams(group, m.config.Teams),
+		}
+		notifyMatch(match, m.config.MsgType)
+		matches = append(matches, match)
+	}
+
+	onMatch := m.onMatch
+	m.mu.Unlock()
+
+	if onMatch != nil {
+		for _, match := range matches {
+			onMatch(match)
+		}
+	}
+
+	return matches
+}
+
+// Update form matches, implements ecs.System
+func (m *Matchmaker) Update(_ context.Context) error {
+	m.Match(time.Now())
+
+	return nil
+}
+
+// findGroup find group of players around the longest waiting player which accept each other,
+// rating difference of every pair fits into windows of both players
+func (m *Matchmaker) findGroup(now time.Time, size int) []Ticket {
+	if len(m.queue) < size {
+		return nil
+	}
+
+	anchors := make([]Ticket, len(m.queue))
+	copy(anchors, m.queue)
+	sort.SliceStable(anchors, func(i, j int) bool {
+		return anchors[i].Enqueued.Before(anchors[j].Enqueued)
+	})
+
+	for _, anchor := range anchors {
+		window := m.Window(anchor, now)
+
+		var candidates []Ticket
+
+		for _, t := range m.queue {
+			if t.Player.GetID() == anchor.Player.GetID() {
+				continue
+			}
+
+			diff := math.Abs(t.Rating - anchor.Rating)
+			if diff <= window && diff <= m.Window(t, now) {
+				candidates = append(candidates, t)
+			}
+		}
+
+		if len(candidates) < size-1 {
+			continue
+		}
+
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return math.Abs(candidates[i].Rating-anchor.Rating) < math.Abs(candidates[j].Rating-anchor.Rating)
+		})
+
+		group := []Ticket{anchor}
+
+		for _, c := range candidates {
+			if len(group) < size && m.acceptsAll(c, group, now) {
+				group = append(group, c)
+			}
+		}
+
+		if len(group) == size {
+			return group
+		}
+	}
+
+	return nil
+}
+
+// acceptsAll return true if ticket and every ticket of group are within windows of each other
+func (m *Matchmaker) acceptsAll(t Ticket, group []Ticket, now time.Time) bool {
+	for _, g := range group {
+		diff := math.Abs(t.Rating - g.Rating)
+		if diff > m.Window(t, now) || diff > m.Window(g, now) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (m *Matchmaker) index(playerID uint64) int {
+	for i, t := range m.queue {
+		if t.Player.GetID() == playerID {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,105 @@
+package lobby
+
+import (
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/InsideGallery/game-core/engine"
+)
+
+// Ticket describe player waiting for the game
+type Ticket struct {
+	Player   engine.Player
+	Rating   float64
+	Enqueued time.Time
+}
+
+// matchIDs source of match ids shared by lobbies and matchmakers
+var matchIDs atomic.Uint64
+
+// Match describe formed game
+type Match struct {
+	ID      uint64 // unique among matches of all lobbies and matchmakers
+	LobbyID uint64 // lobby which started match, 0 for matchmaker
+	Teams   [][]Ticket
+}
+
+// nextMatchID return new match id
+func nextMatchID() uint64 {
+	return matchIDs.Add(1)
+}
+
+// Players return all players of match
+func (m *Match) Players() []engine.Player {
+	var players []engine.Player
+
+	for _, team := range m.Teams {
+		for _, t := range team {
+			players = append(players, t.Player)
+		}
+	}
+
+	return players
+}
+
+// TeamIDs return player ids by team
+func (m *Match) TeamIDs() [][]uint64 {
+	ids := make([][]uint64, len(m.Teams))
+
+	for i, team := range m.Teams {
+		ids[i] = make([]uint64, len(team))
+		for j, t := range team {
+			ids[i][j] = t.Player.GetID()
+		}
+	}
+
+	return ids
+}
+
+// TeamRating return sum of ratings of team
+func TeamRating(team []Ticket) float64 {
+	var sum float64
+	for _, t := range team {
+		sum += t.Rating
+	}
+
+	return sum
+}
+
+// BalanceTeams split tickets into given count of teams with closest total ratings
+// Strongest players assigned first, each to the weakest team which still has free slot
+func BalanceTeams(tickets []Ticket, teams int) [][]Ticket {
+	if teams <= 0 {
+		return nil
+	}
+
+	sorted := make([]Ticket, len(tickets))
+	copy(sorted, tickets)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Rating > sorted[j].Rating
+	})
+
+	size := (len(sorted) + teams - 1) / teams
+	result := make([][]Ticket, teams)
+	ratings := make([]float64, teams)
+
+	for _, t := range sorted {
+		best := -1
+
+		for i := range result {
+			if len(result[i]) >= size {
+				continue
+			}
+
+			if best == -1 || ratings[i] < ratings[best] {
+				best = i
+			}
+		}
+
+		result[best] = append(result[best], t)
+		ratings[best] += t.Rating
+	}
+
+	return result
+}
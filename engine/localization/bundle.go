@@ -0,0 +1,278 @@
+package localization
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Localized describe entity with preferred locale
+type Localized interface {
+	GetLocale() string
+}
+
+// message contains translation by plural forms, singular translation stored as Other
+type message map[PluralForm]string
+
+// Bundle contains translation catalogs
+type Bundle struct {
+	defaultLocale string
+	catalogs      map[string]map[string]message
+
+	mu sync.RWMutex
+}
+
+// NewBundle return new bundle with fallback locale
+func NewBundle(defaultLocale string) *Bundle {
+	return &Bundle{
+		defaultLocale: NormalizeLocale(defaultLocale),
+		catalogs:      make(map[string]map[string]message),
+	}
+}
+
+// NormalizeLocale return canonical form of locale (en_US -> en-us)
+func NormalizeLocale(locale string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(locale)), "_", "-")
+}
+
+// Locales return loaded locales
+func (b *Bundle) Locales() []string {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	locales := make([]string, 0, len(b.catalogs))
+	for l := range b.catalogs {
+		locales = append(locales, l)
+	}
+
+	return locales
+}
+
+// Add add singular translation
+func (b *Bundle) Add(locale, key, text string) {
+	b.add(locale, key, message{Other: text})
+}
+
+// AddPlural add translation with plural forms
+func (b *Bundle) AddPlural(locale, key string, forms map[PluralForm]string) {
+	m := make(message, len(forms))
+	for f, text := range forms {
+		m[f] = text
+	}
+
+	b.add(locale, key, m)
+}
+
+// LoadJSON load catalog in JSON format
+// Value is either string or object with plural forms: {"apples": {"one": "{count} apple", "other": "{count} apples"}}
+func (b *Bundle) LoadJSON(locale string, data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidCatalog, err)
+	}
+
+	catalog := make(map[string]message, len(raw))
+
+	for key, value := range raw {
+		var text string
+		if err := json.Unmarshal(value, &text); err == nil {
+			catalog[key] = message{Other: text}
+			continue
+		}
+
+		var forms map[string]string
+		if err := json.Unmarshal(value, &forms); err != nil {
+			return fmt.Errorf("%w: key %q", ErrInvalidCatalog, key)
+		}
+
+		m := make(message, len(forms))
+
+		for name, text := range forms {
+			f, exists := ParsePluralForm(name)
+			if !exists {
+				return fmt.Errorf("%w: key %q unknown plural form %q", ErrInvalidCatalog, key, name)
+			}
+
+			m[f] = text
+		}
+
+		catalog[key] = m
+	}
+
+	b.merge(locale, catalog)
+
+	return nil
+}
+
+// LoadPO load catalog in gettext PO format, msgctxt joined with msgid by "\x04"
+func (b *Bundle) LoadPO(locale string, data []byte) error {
+	entries, err := parsePO(string(data))
+	if err != nil {
+		return err
+	}
+
+	rule := GetPluralRule(locale)
+	catalog := make(map[string]message, len(entries))
+
+	for _, e := range entries {
+		if e.id == "" {
+			continue // header
+		}
+
+		key := e.id
+		if e.context != "" {
+			key = e.context + "\x04" + e.id
+		}
+
+		if e.plural == "" {
+			if e.str[0] != "" {
+				catalog[key] = message{Other: e.str[0]}
+			}
+
+			continue
+		}
+
+		m := message{}
+
+		for i, text := range e.str {
+			if i < len(rule.Forms) && text != "" {
+				m[rule.Forms[i]] = text
+			}
+		}
+
+		if len(m) != 0 {
+			catalog[key] = m
+		}
+	}
+
+	b.merge(locale, catalog)
+
+	return nil
+}
+
+// Has return true if key translated for locale or its fallbacks
+func (b *Bundle) Has(locale, key string) bool {
+	_, _, exists := b.lookup(locale, key)
+
+	return exists
+}
+
+// Format return translation with replaced {name} parameters, key returned if translation not found
+func (b *Bundle) Format(locale, key string, params map[string]any) string {
+	m, _, exists := b.lookup(locale, key)
+	if !exists {
+		return replace(key, params)
+	}
+
+	return replace(m[Other], params)
+}
+
+// Plural return translation in plural form for count, {count} parameter filled automatically
+// Form chosen by plural rule of locale where translation was found
+func (b *Bundle) Plural(locale, key string, count int, params map[string]any) string {
+	p := make(map[string]any, len(params)+1)
+	p["count"] = count
+
+	for k, v := range params {
+		p[k] = v
+	}
+
+	m, found, exists := b.lookup(locale, key)
+	if !exists {
+		return replace(key, p)
+	}
+
+	form := GetPluralRule(found).Select(count)
+	if count == 0 {
+		if text, exists := m[Zero]; exists {
+			return replace(text, p)
+		}
+	}
+
+	text, exists := m[form]
+	if !exists {
+		text = m[Other]
+	}
+
+	return replace(text, p)
+}
+
+// FormatFor return translation for locale of given entity
+func (b *Bundle) FormatFor(l Localized, key string, params map[string]any) string {
+	return b.Format(l.GetLocale(), key, params)
+}
+
+// PluralFor return plural translation for locale of given entity
+func (b *Bundle) PluralFor(l Localized, key string, count int, params map[string]any) string {
+	return b.Plural(l.GetLocale(), key, count, params)
+}
+
+// lookup search key in locale, then base language, then default locale, return message and its locale
+func (b *Bundle) lookup(locale, key string) (message, string, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, l := range Fallbacks(locale, b.defaultLocale) {
+		if m, exists := b.catalogs[l][key]; exists {
+			return m, l, true
+		}
+	}
+
+	return nil, "", false
+}
+
+func (b *Bundle) add(locale, key string, m message) {
+	b.merge(locale, map[string]message{key: m})
+}
+
+func (b *Bundle) merge(locale string, catalog map[string]message) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	locale = NormalizeLocale(locale)
+
+	c, exists := b.catalogs[locale]
+	if !exists {
+		c = make(map[string]message, len(catalog))
+		b.catalogs[locale] = c
+	}
+
+	for k, m := range catalog {
+		c[k] = m
+	}
+}
+
+// Fallbacks return chain of locales to search translation: locale, base language, default locale
+func Fallbacks(locale, defaultLocale string) []string {
+	locale = NormalizeLocale(locale)
+	defaultLocale = NormalizeLocale(defaultLocale)
+
+	result := []string{locale}
+	if base := BaseLanguage(locale); base != locale {
+		result = append(result, base)
+	}
+
+	if defaultLocale != "" && defaultLocale != locale {
+		result = append(result, defaultLocale)
+		if base := BaseLanguage(defaultLocale); base != defaultLocale {
+			result = append(result, base)
+		}
+	}
+
+	return result
+}
+
+// replace replace {name} placeholders by parameters
+func replace(text string, params map[string]any) string {
+	if len(params) == 0 || !strings.Contains(text, "{") {
+		return text
+	}
+
+	pairs := make([]string, 0, len(params)*2) //nolint:mnd
+	for k, v := range params {
+		pairs = append(pairs, "{"+k+"}", fmt.Sprint(v))
+	}
+
+	return strings.NewReplacer(pairs...).Replace(text)
+}
@@ -0,0 +1,35 @@
+package localization
+
+import "sync"
+
+// LocaleComponent contains preferred locale of entity
+type LocaleComponent struct {
+	locale string
+
+	mu sync.RWMutex
+}
+
+// NewLocaleComponent return new locale component
+func NewLocaleComponent(locale string) *LocaleComponent {
+	return &LocaleComponent{
+		locale: NormalizeLocale(locale),
+	}
+}
+
+// SetLocale set locale
+func (l *LocaleComponent) SetLocale(locale string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.locale = NormalizeLocale(locale)
+}
+
+// GetLocale get locale
+func (l *LocaleComponent) GetLocale() string {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	locale := l.locale
+
+	return locale
+}
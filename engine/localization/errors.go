@@ -0,0 +1,9 @@
+package localization
+
+import "errors"
+
+// All kind of errors for localization
+var (
+	ErrInvalidCatalog = errors.New("invalid translation catalog")
+	ErrInvalidPO      = errors.New("invalid po file")
+)
@@ -0,0 +1,71 @@
+package localization
+
+import (
+	"testing"
+
+	"github.com/InsideGallery/core/testutils"
+)
+
+const catalogEN = `{
+	"welcome": "Welcome, {name}!",
+	"apples": {"one": "{count} apple", "other": "{count} apples"}
+}`
+
+const catalogUK = `
+# Ukrainian translation
+msgid ""
+msgstr ""
+"Language: uk\n"
+
+msgid "welcome"
+msgstr "Вітаємо, {name}!"
+
+#, fuzzy
+msgid "bye"
+msgstr "Бувай"
+
+msgctxt "menu"
+msgid "exit"
+msgstr "Вихід"
+
+msgid "apple"
+msgid_plural "apples"
+msgstr[0] "{count} яблуко"
+msgstr[1] "{count} яблука"
+msgstr[2] "{count} "
+"яблук"
+`
+
+func TestBundle(t *testing.T) {
+	b := NewBundle("en")
+	testutils.Equal(t, b.LoadJSON("en", []byte(catalogEN)), nil)
+	testutils.Equal(t, b.LoadPO("uk", []byte(catalogUK)), nil)
+
+	params := map[string]any{"name": "Bob"}
+	testutils.Equal(t, b.Format("en-US", "welcome", params), "Welcome, Bob!")
+	testutils.Equal(t, b.Format("uk_UA", "welcome", params), "Вітаємо, Bob!")
+	testutils.Equal(t, b.Format("fr", "welcome", params), "Welcome, Bob!")
+	testutils.Equal(t, b.Format("uk", "bye", nil), "bye")
+	testutils.Equal(t, b.Format("uk", "menu\x04exit", nil), "Вихід")
+	testutils.Equal(t, b.Has("de", "unknown"), false)
+
+	testutils.Equal(t, b.Plural("en", "apples", 1, nil), "1 apple")
+	testutils.Equal(t, b.Plural("en", "apples", 5, nil), "5 apples")
+	testutils.Equal(t, b.Plural("ja", "apples", 1, nil), "1 apple")
+	testutils.Equal(t, b.Plural("uk", "apple", 1, nil), "1 яблуко")
+	testutils.Equal(t, b.Plural("uk", "apple", 3, nil), "3 яблука")
+	testutils.Equal(t, b.Plural("uk", "apple", 11, nil), "11 яблук")
+	testutils.Equal(t, b.Plural("uk", "apple", 21, nil), "21 яблуко")
+
+	player := NewLocaleComponent("uk_UA")
+	testutils.Equal(t, player.GetLocale(), "uk-ua")
+	testutils.Equal(t, b.FormatFor(player, "welcome", params), "Вітаємо, Bob!")
+	testutils.Equal(t, b.PluralFor(player, "apple", 2, nil), "2 яблука")
+
+	b.AddPlural("en", "lives", map[PluralForm]string{Zero: "no lives", One: "one life", Other: "{count} lives"})
+	testutils.Equal(t, b.Plural("en", "lives", 0, nil), "no lives")
+	testutils.Equal(t, b.Plural("en", "lives", 2, nil), "2 lives")
+
+	testutils.NotEqual(t, b.LoadJSON("en", []byte(`{"a": {"lots": "x"}}`)), nil)
+	testutils.NotEqual(t, b.LoadPO("en", []byte(`msgid "a`)), nil)
+}
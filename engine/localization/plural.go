@@ -0,0 +1,145 @@
+package localization
+
+import (
+	"strings"
+	"sync"
+)
+
+// PluralForm describe CLDR plural category
+type PluralForm uint8
+
+// Plural forms
+const (
+	Other PluralForm = iota
+	Zero
+	One
+	Two
+	Few
+	Many
+)
+
+var pluralFormNames = map[string]PluralForm{
+	"other": Other,
+	"zero":  Zero,
+	"one":   One,
+	"two":   Two,
+	"few":   Few,
+	"many":  Many,
+}
+
+// ParsePluralForm return plural form by CLDR name
+func ParsePluralForm(name string) (PluralForm, bool) {
+	f, exists := pluralFormNames[name]
+
+	return f, exists
+}
+
+// PluralRule describe plural rule of language
+type PluralRule struct {
+	Forms  []PluralForm // forms in order of po msgstr indexes
+	Select func(n int) PluralForm
+}
+
+var (
+	oneOther = PluralRule{
+		Forms: []PluralForm{One, Other},
+		Select: func(n int) PluralForm {
+			if n == 1 {
+				return One
+			}
+
+			return Other
+		},
+	}
+	zeroAsOneOther = PluralRule{
+		Forms: []PluralForm{One, Other},
+		Select: func(n int) PluralForm {
+			if n == 0 || n == 1 {
+				return One
+			}
+
+			return Other
+		},
+	}
+	otherOnly = PluralRule{
+		Forms: []PluralForm{Other},
+		Select: func(_ int) PluralForm {
+			return Other
+		},
+	}
+	slavic = PluralRule{
+		Forms: []PluralForm{One, Few, Many},
+		Select: func(n int) PluralForm {
+			if n < 0 {
+				n = -n
+			}
+
+			switch {
+			case n%10 == 1 && n%100 != 11:
+				return One
+			case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+				return Few
+			default:
+				return Many
+			}
+		},
+	}
+	polish = PluralRule{
+		Forms: []PluralForm{One, Few, Many},
+		Select: func(n int) PluralForm {
+			if n < 0 {
+				n = -n
+			}
+
+			switch {
+			case n == 1:
+				return One
+			case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+				return Few
+			default:
+				return Many
+			}
+		},
+	}
+)
+
+var (
+	pluralRules = map[string]PluralRule{
+		"en": oneOther, "de": oneOther, "es": oneOther, "it": oneOther, "nl": oneOther, "sv": oneOther,
+		"fr": zeroAsOneOther, "pt": zeroAsOneOther,
+		"ja": otherOnly, "zh": otherOnly, "ko": otherOnly, "vi": otherOnly, "th": otherOnly,
+		"ru": slavic, "uk": slavic, "be": slavic,
+		"pl": polish,
+	}
+	pluralMu sync.RWMutex
+)
+
+// RegisterPluralRule register plural rule for language
+func RegisterPluralRule(lang string, rule PluralRule) {
+	pluralMu.Lock()
+	defer pluralMu.Unlock()
+
+	pluralRules[BaseLanguage(lang)] = rule
+}
+
+// GetPluralRule return plural rule for locale, english rule used by default
+func GetPluralRule(locale string) PluralRule {
+	pluralMu.RLock()
+	defer pluralMu.RUnlock()
+
+	if rule, exists := pluralRules[BaseLanguage(locale)]; exists {
+		return rule
+	}
+
+	return oneOther
+}
+
+// BaseLanguage return language part of locale (en-US -> en)
+func BaseLanguage(locale string) string {
+	locale = strings.ToLower(locale)
+	if i := strings.IndexAny(locale, "-_"); i != -1 {
+		return locale[:i]
+	}
+
+	return locale
+}
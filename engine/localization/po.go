@@ -0,0 +1,125 @@
+package localization
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// poEntry describe single entry of po file
+type poEntry struct {
+	context string
+	id      string
+	plural  string
+	str     []string
+	fuzzy   bool
+}
+
+// parsePO parse gettext po file, fuzzy entries skipped
+func parsePO(data string) ([]poEntry, error) {
+	var entries []poEntry
+	var current poEntry
+	var target *string
+	started := false
+
+	flush := func() {
+		if started && !current.fuzzy {
+			if len(current.str) == 0 {
+				current.str = []string{""}
+			}
+
+			entries = append(entries, current)
+		}
+
+		current = poEntry{}
+		target = nil
+		started = false
+	}
+
+	for n, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+
+		switch {
+		case line == "":
+			continue
+		case strings.HasPrefix(line, "#,"):
+			if started && len(current.str) != 0 {
+				flush()
+			}
+
+			if strings.Contains(line, "fuzzy") {
+				current.fuzzy = true
+			}
+
+			continue
+		case strings.HasPrefix(line, "#"):
+			continue
+		case strings.HasPrefix(line, `"`):
+			if target == nil {
+				return nil, fmt.Errorf("%w: line %d unexpected string", ErrInvalidPO, n+1)
+			}
+
+			s, err := strconv.Unquote(line)
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: %w", ErrInvalidPO, n+1, err)
+			}
+
+			*target += s
+
+			continue
+		}
+
+		keyword, value, found := strings.Cut(line, " ")
+		if !found {
+			return nil, fmt.Errorf("%w: line %d", ErrInvalidPO, n+1)
+		}
+
+		s, err := strconv.Unquote(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %w", ErrInvalidPO, n+1, err)
+		}
+
+		switch {
+		case keyword == "msgctxt":
+			if started && len(current.str) != 0 {
+				flush()
+			}
+
+			started = true
+			current.context = s
+			target = &current.context
+		case keyword == "msgid":
+			if started && len(current.str) != 0 {
+				flush()
+			}
+
+			started = true
+			current.id = s
+			target = &current.id
+		case keyword == "msgid_plural":
+			current.plural = s
+			target = &current.plural
+		case keyword == "msgstr":
+			current.str = append(current.str, s)
+			target = &current.str[len(current.str)-1]
+		case strings.HasPrefix(keyword, "msgstr[") && strings.HasSuffix(keyword, "]"):
+			i, err := strconv.Atoi(keyword[len("msgstr[") : len(keyword)-1])
+			if err != nil || i < 0 {
+				return nil, fmt.Errorf("%w: line %d invalid index", ErrInvalidPO, n+1)
+			}
+
+			for len(current.str) <= i {
+				current.str = append(current.str, "")
+			}
+
+			current.str[i] = s
+			target = &current.str[i]
+		default:
+			return nil, fmt.Errorf("%w: line %d unknown keyword %q", ErrInvalidPO, n+1, keyword)
+		}
+	}
+
+	flush()
+
+	return entries, nil
+}
@@ -0,0 +1,427 @@
+package lockstep
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/InsideGallery/game-core/engine"
+	"github.com/InsideGallery/game-core/engine/communications"
+)
+
+// pendingHashes count of hash intervals to keep hashes while waiting for reports of all players
+const pendingHashes = 64
+
+// Desync describe state hash mismatch between players
+type Desync struct {
+	Tick   uint64
+	Local  uint64
+	Hashes map[uint64]uint64 // player id -> reported hash
+}
+
+// Coordinator advance game only when inputs of all players for tick are present
+type Coordinator struct {
+	game         engine.Game
+	state        engine.Checksummer
+	inputDelay   uint64
+	hashInterval uint64
+	msgType      uint8
+	tick         uint64
+
+	players     map[uint64]communications.Communication
+	since       map[uint64]uint64
+	inputs      map[uint64]map[uint64][]communications.Command
+	hashes      map[uint64]map[uint64]uint64
+	localHashes map[uint64]uint64
+	checked     uint64 // last tick which hashes of all players matched
+	desync      *Desync
+	onDesync    func(d Desync)
+
+	mu   sync.Mutex
+	exec sync.Mutex // serialize execution of ticks
+}
+
+// NewCoordinator return new lockstep coordinator
+// inputDelay - count of ticks between input and its execution
+// hashInterval - state of game hashed every hashInterval ticks (0 - disabled)
+// msgType - type of tick message sent to players
+func NewCoordinator(game engine.Game, inputDelay, hashInterval uint64, msgType uint8) *Coordinator {
+	state, _ := game.(engine.Checksummer)
+
+	return &Coordinator{
+		game:         game,
+		state:        state,
+		inputDelay:   inputDelay,
+		hashInterval: hashInterval,
+		msgType:      msgType,
+		players:      make(map[uint64]communications.Communication),
+		since:        make(map[uint64]uint64),
+		inputs:       make(map[uint64]map[uint64][]communications.Command),
+		hashes:       make(map[uint64]map[uint64]uint64),
+		localHashes:  make(map[uint64]uint64),
+	}
+}
+
+// OnDesync set callback called when earlier desync than known one is detected
+func (c *Coordinator) OnDesync(f func(d Desync)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.onDesync = f
+}
+
+// AddPlayer add player, his inputs required since current tick plus input delay
+func (c *Coordinator) AddPlayer(p engine.Player) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	id := p.GetID()
+	if _, exists := c.players[id]; exists {
+		return ErrPlayerAlreadyExists
+	}
+
+	c.players[id] = p
+	c.since[id] = c.tick + c.inputDelay
+
+	return nil
+}
+
+// RemovePlayer remove player, his inputs no longer required
+func (c *Coordinator) RemovePlayer(id uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.players, id)
+	delete(c.since, id)
+
+	for _, inputs := range c.inputs {
+		delete(inputs, id)
+	}
+}
+
+// Tick return next tick to execute
+func (c *Coordinator) Tick() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	tick := c.tick
+
+	return tick
+}
+
+// InputDelay return input delay
+func (c *Coordinator) InputDelay() uint64 {
+	return c.inputDelay
+}
+
+// Submit submit player commands issued at given tick, commands executed at tick plus input delay
+func (c *Coordinator) Submit(playerID, tick uint64, cmds ...communications.Command) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	since, exists := c.since[playerID]
+	if !exists {
+		return ErrUnknownPlayer
+	}
+
+	target := tick + c.inputDelay
+	if target < c.tick || target < since {
+		return ErrInputTooLate
+	}
+
+	inputs, exists := c.inputs[target]
+	if !exists {
+		inputs = make(map[uint64][]communications.Command)
+		c.inputs[target] = inputs
+	}
+
+	if _, exists := inputs[playerID]; exists {
+		return ErrInputAlreadySubmitted
+	}
+
+	inputs[playerID] = append([]communications.Command{}, cmds...)
+
+	return nil
+}
+
+// Ready return true if inputs of all players for next tick are present
+func (c *Coordinator) Ready() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.ready()
+}
+
+// Waiting return players which inputs for next tick are missing
+func (c *Coordinator) Waiting() []uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var ids []uint64
+
+	for id, since := range c.since {
+		if since > c.tick {
+			continue
+		}
+
+		if _, exists := c.inputs[c.tick][id]; !exists {
+			ids = append(ids, id)
+		}
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	return ids
+}
+
+func (c *Coordinator) ready() bool {
+	for id, since := range c.since {
+		if since > c.tick {
+			continue
+		}
+
+		if _, exists := c.inputs[c.tick][id]; !exists {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Advance execute next tick if all inputs are present, return true if tick was executed
+// Command errors do not stop the tick: all commands are executed and their errors are returned joined.
+// Commands and game tick run without coordinator lock, so they may call Submit and ReportHash,
+// but must not call Advance or Update
+func (c *Coordinator) Advance(ctx context.Context) (bool, error) {
+	c.exec.Lock()
+	defer c.exec.Unlock()
+
+	c.mu.Lock()
+
+	if len(c.players) == 0 {
+		c.mu.Unlock()
+		return false, ErrNoPlayers
+	}
+
+	if !c.ready() {
+		c.mu.Unlock()
+		return false, nil
+	}
+
+	tick := c.tick
+	msg := &TickMessage{
+		MsgType: c.msgType,
+		Tick:    tick,
+		Inputs:  c.orderedInputs(tick),
+	}
+	delete(c.inputs, tick)
+	c.tick++
+	c.mu.Unlock()
+
+	var errs []error
+
+	for _, in := range msg.Inputs {
+		for _, cmd := range in.Commands {
+			if err := cmd.Execute(ctx); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	c.game.Tick(ctx)
+
+	var checksum uint64
+
+	hashed := c.state != nil && c.hashed(tick)
+	if hashed {
+		checksum = c.state.Checksum()
+	}
+
+	c.mu.Lock()
+
+	var desync *Desync
+
+	if hashed {
+		c.localHashes[tick] = checksum
+		desync = c.check(tick)
+	}
+
+	c.prune()
+
+	for _, p := range c.players {
+		if p != nil {
+			p.AddMessageToQueue(msg)
+		}
+	}
+
+	onDesync := c.onDesync
+	c.mu.Unlock()
+
+	if desync != nil && onDesync != nil {
+		onDesync(*desync)
+	}
+
+	return true, errors.Join(errs...)
+}
+
+// Update advance game, implements ecs.System
+func (c *Coordinator) Update(ctx context.Context) error {
+	_, err := c.Advance(ctx)
+
+	return err
+}
+
+// ReportHash report state hash of player for tick
+// Reports for ticks which are not hashed, not executed yet or too old to be kept are ignored
+func (c *Coordinator) ReportHash(playerID, tick, hash uint64) error {
+	c.mu.Lock()
+
+	if _, exists := c.players[playerID]; !exists {
+		c.mu.Unlock()
+		return ErrUnknownPlayer
+	}
+
+	if !c.hashed(tick) || tick >= c.tick || tick < c.oldestHash() {
+		c.mu.Unlock()
+		return nil
+	}
+
+	hashes, exists := c.hashes[tick]
+	if !exists {
+		hashes = make(map[uint64]uint64)
+		c.hashes[tick] = hashes
+	}
+
+	hashes[playerID] = hash
+
+	desync := c.check(tick)
+	onDesync := c.onDesync
+	c.mu.Unlock()
+
+	if desync != nil && onDesync != nil {
+		onDesync(*desync)
+	}
+
+	return nil
+}
+
+// FirstDesync return first tick with different hashes
+func (c *Coordinator) FirstDesync() (Desync, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.desync == nil {
+		return Desync{}, false
+	}
+
+	return *c.desync, true
+}
+
+// check compare hashes for tick, return desync if it is the first one detected for tick
+func (c *Coordinator) check(tick uint64) *Desync {
+	local, hasLocal := c.localHashes[tick]
+	hashes := c.hashes[tick]
+
+	var reference uint64
+	var hasReference bool
+
+	if hasLocal {
+		reference, hasReference = local, true
+	}
+
+	diverged := false
+
+	for _, h := range hashes {
+		if !hasReference {
+			reference, hasReference = h, true
+			continue
+		}
+
+		if h != reference {
+			diverged = true
+			break
+		}
+	}
+
+	if !diverged {
+		if hasLocal && len(hashes) >= len(c.players) {
+			delete(c.hashes, tick)
+			delete(c.localHashes, tick)
+			c.checked = max(c.checked, tick)
+		}
+
+		return nil
+	}
+
+	if c.desync != nil && c.desync.Tick <= tick {
+		return nil
+	}
+
+	d := &Desync{
+		Tick:   tick,
+		Local:  local,
+		Hashes: make(map[uint64]uint64, len(hashes)),
+	}
+
+	for id, h := range hashes {
+		d.Hashes[id] = h
+	}
+
+	c.desync = d
+
+	return d
+}
+
+// hashed return true if state hashed at tick
+func (c *Coordinator) hashed(tick uint64) bool {
+	return c.hashInterval != 0 && tick%c.hashInterval == 0
+}
+
+// oldestHash return the oldest tick which hashes are kept for
+func (c *Coordinator) oldestHash() uint64 {
+	oldest := c.checked
+	if window := c.hashInterval * pendingHashes; c.tick > window {
+		oldest = max(oldest, c.tick-window)
+	}
+
+	return oldest
+}
+
+// prune remove hashes of ticks before the last checked one and out of pending window
+func (c *Coordinator) prune() {
+	oldest := c.oldestHash()
+
+	for tick := range c.hashes {
+		if tick < oldest {
+			delete(c.hashes, tick)
+		}
+	}
+
+	for tick := range c.localHashes {
+		if tick < oldest {
+			delete(c.localHashes, tick)
+		}
+	}
+}
+
+// orderedInputs return inputs for tick ordered by player id
+func (c *Coordinator) orderedInputs(tick uint64) []PlayerInput {
+	inputs := c.inputs[tick]
+	result := make([]PlayerInput, 0, len(inputs))
+
+	for id, cmds := range inputs {
+		result = append(result, PlayerInput{
+			PlayerID: id,
+			Commands: cmds,
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].PlayerID < result[j].PlayerID
+	})
+
+	return result
+}
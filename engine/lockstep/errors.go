@@ -0,0 +1,13 @@
+package lockstep
+
+import "errors"
+
+// All kind of errors for lockstep
+var (
+	ErrUnknownPlayer         = errors.New("unknown player")
+	ErrPlayerAlreadyExists   = errors.New("player already exists")
+	ErrInputTooLate          = errors.New("input scheduled for already executed tick")
+	ErrInputAlreadySubmitted = errors.New("input for tick already submitted")
+	ErrNoPlayers             = errors.New("no players in lockstep")
+	ErrMalformedMessage      = errors.New("malformed tick message")
+)
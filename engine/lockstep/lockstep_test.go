@@ -0,0 +1,221 @@
+package lockstep
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/InsideGallery/game-core/engine/communications"
+	"github.com/InsideGallery/game-core/engine/enginetest"
+
+	"github.com/InsideGallery/core/testutils"
+)
+
+type sumGame struct {
+	sum   uint64
+	ticks uint64
+}
+
+func (g *sumGame) Initialize() error { return nil }
+
+func (g *sumGame) Tick(_ context.Context) { g.ticks++ }
+
+func (g *sumGame) Checksum() uint64 { return g.sum*31 + g.ticks }
+
+type addCommand struct {
+	game  *sumGame
+	value byte
+}
+
+func (c *addCommand) GetMsgType() uint8 { return 1 }
+
+func (c *addCommand) Decode(msg []byte) { c.value = msg[0] }
+
+func (c *addCommand) Encode() []byte { return []byte{c.value} }
+
+func (c *addCommand) Execute(_ context.Context) error {
+	c.game.sum = c.game.sum*10 + uint64(c.value)
+	return nil
+}
+
+type addParser struct {
+	game *sumGame
+}
+
+func (p *addParser) Parse(msg []byte) (communications.Command, error) {
+	c := &addCommand{game: p.game}
+	c.Decode(msg)
+
+	return c, nil
+}
+
+func TestLockstep(t *testing.T) {
+	ctx := context.Background()
+	game := &sumGame{}
+	c := NewCoordinator(game, 2, 1, 7)
+
+	p1, p2 := enginetest.NewPlayer(1), enginetest.NewPlayer(2)
+	testutils.Equal(t, c.AddPlayer(p1), nil)
+	testutils.Equal(t, c.AddPlayer(p2), nil)
+	testutils.Equal(t, c.AddPlayer(p1), ErrPlayerAlreadyExists)
+
+	// first ticks covered by input delay
+	for i := 0; i < 2; i++ {
+		ok, err := c.Advance(ctx)
+		testutils.Equal(t, err, nil)
+		testutils.Equal(t, ok, true)
+	}
+
+	ok, err := c.Advance(ctx)
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, ok, false)
+
+	testutils.Equal(t, c.Submit(2, 0, &addCommand{game: game, value: 2}), nil)
+	testutils.Equal(t, c.Waiting(), []uint64{1})
+	testutils.Equal(t, c.Ready(), false)
+	testutils.Equal(t, c.Submit(1, 0, &addCommand{game: game, value: 1}), nil)
+	testutils.Equal(t, c.Submit(1, 0), ErrInputAlreadySubmitted)
+	testutils.Equal(t, c.Submit(3, 0), ErrUnknownPlayer)
+
+	ok, err = c.Advance(ctx)
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, ok, true)
+	// inputs executed in player order
+	testutils.Equal(t, game.sum, uint64(12))
+	testutils.Equal(t, c.Tick(), uint64(3))
+	testutils.Equal(t, c.Submit(1, 0), ErrInputTooLate)
+
+	queue := p1.GetQueue()
+	testutils.Equal(t, len(queue), 3)
+
+	msg, err := DecodeTickMessage(queue[2], &addParser{game: game})
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, msg.MsgType, uint8(7))
+	testutils.Equal(t, msg.Tick, uint64(2))
+	testutils.Equal(t, len(msg.Inputs), 2)
+	testutils.Equal(t, msg.Inputs[0].PlayerID, uint64(1))
+	testutils.Equal(t, msg.Inputs[1].Commands[0].Encode(), []byte{2})
+}
+
+func TestDesync(t *testing.T) {
+	ctx := context.Background()
+	game := &sumGame{}
+	c := NewCoordinator(game, 0, 1, 0)
+	testutils.Equal(t, c.AddPlayer(enginetest.NewPlayer(1)), nil)
+	testutils.Equal(t, c.AddPlayer(enginetest.NewPlayer(2)), nil)
+
+	var reported []uint64
+	c.OnDesync(func(d Desync) {
+		reported = append(reported, d.Tick)
+	})
+
+	for i := uint64(0); i < 3; i++ {
+		testutils.Equal(t, c.Submit(1, i), nil)
+		testutils.Equal(t, c.Submit(2, i), nil)
+		ok, err := c.Advance(ctx)
+		testutils.Equal(t, err, nil)
+		testutils.Equal(t, ok, true)
+	}
+
+	testutils.Equal(t, c.ReportHash(1, 0, 1), nil)
+	testutils.Equal(t, c.ReportHash(2, 0, 1), nil)
+	_, found := c.FirstDesync()
+	testutils.Equal(t, found, false)
+
+	testutils.Equal(t, c.ReportHash(1, 2, 4), nil)
+	testutils.Equal(t, c.ReportHash(2, 1, 5), nil)
+	testutils.Equal(t, c.ReportHash(3, 1, 2), ErrUnknownPlayer)
+
+	d, found := c.FirstDesync()
+	testutils.Equal(t, found, true)
+	testutils.Equal(t, d.Tick, uint64(1))
+	testutils.Equal(t, d.Local, uint64(2))
+	testutils.Equal(t, reported, []uint64{2, 1})
+}
+
+var errFailed = errors.New("failed")
+
+// failCommand fail and submit next input of player from inside of tick
+type failCommand struct {
+	coordinator *Coordinator
+}
+
+func (c *failCommand) GetMsgType() uint8 { return 2 }
+
+func (c *failCommand) Decode(_ []byte) {}
+
+func (c *failCommand) Encode() []byte { return nil }
+
+func (c *failCommand) Execute(_ context.Context) error {
+	if err := c.coordinator.Submit(1, 1); err != nil {
+		return err
+	}
+
+	return errFailed
+}
+
+func TestCommandError(t *testing.T) {
+	ctx := context.Background()
+	game := &sumGame{}
+	c := NewCoordinator(game, 0, 0, 0)
+	p1, p2 := enginetest.NewPlayer(1), enginetest.NewPlayer(2)
+	testutils.Equal(t, c.AddPlayer(p1), nil)
+	testutils.Equal(t, c.AddPlayer(p2), nil)
+
+	testutils.Equal(t, c.Submit(1, 0, &failCommand{coordinator: c}, &addCommand{game: game, value: 1}), nil)
+	testutils.Equal(t, c.Submit(2, 0, &addCommand{game: game, value: 2}), nil)
+
+	ok, err := c.Advance(ctx)
+	testutils.Equal(t, ok, true)
+	testutils.Equal(t, errors.Is(err, errFailed), true)
+
+	// whole tick executed and sent to players
+	testutils.Equal(t, game.sum, uint64(12))
+	testutils.Equal(t, game.ticks, uint64(1))
+	testutils.Equal(t, c.Tick(), uint64(1))
+	testutils.Equal(t, len(p2.GetQueue()), 1)
+	testutils.Equal(t, c.Waiting(), []uint64{2})
+}
+
+func TestHashesBounded(t *testing.T) {
+	ctx := context.Background()
+	game := &sumGame{}
+	c := NewCoordinator(game, 0, 2, 0)
+	testutils.Equal(t, c.AddPlayer(enginetest.NewPlayer(1)), nil)
+	testutils.Equal(t, c.AddPlayer(enginetest.NewPlayer(2)), nil)
+
+	for i := uint64(0); i < 1000; i++ {
+		testutils.Equal(t, c.Submit(1, i), nil)
+		testutils.Equal(t, c.Submit(2, i), nil)
+		ok, err := c.Advance(ctx)
+		testutils.Equal(t, err, nil)
+		testutils.Equal(t, ok, true)
+
+		// player 2 never report, player 1 report every tick, not hashed and future ones included
+		testutils.Equal(t, c.ReportHash(1, i, 0), nil)
+		testutils.Equal(t, c.ReportHash(1, i+1_000_000, 0), nil)
+	}
+
+	testutils.Equal(t, len(c.hashes) <= pendingHashes, true)
+	testutils.Equal(t, len(c.localHashes) <= pendingHashes, true)
+
+	for tick := range c.hashes {
+		testutils.Equal(t, tick%2, uint64(0))
+		testutils.Equal(t, tick < c.Tick(), true)
+	}
+
+	_, found := c.FirstDesync()
+	testutils.Equal(t, found, true)
+
+	// fully checked tick drop everything before it
+	tick := c.Tick() - 2
+	testutils.Equal(t, c.ReportHash(1, tick, game.Checksum()-1), nil)
+	testutils.Equal(t, c.ReportHash(2, tick, game.Checksum()-1), nil)
+	testutils.Equal(t, c.Submit(1, c.Tick()), nil)
+	testutils.Equal(t, c.Submit(2, c.Tick()), nil)
+	ok, err := c.Advance(ctx)
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, ok, true)
+	testutils.Equal(t, len(c.hashes), 0)
+	testutils.Equal(t, len(c.localHashes), 1)
+}
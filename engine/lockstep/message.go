@@ -0,0 +1,116 @@
+package lockstep
+
+import (
+	"encoding/binary"
+
+	"github.com/InsideGallery/game-core/engine/communications"
+)
+
+// PlayerInput contains commands of single player for tick
+type PlayerInput struct {
+	PlayerID uint64
+	Commands []communications.Command
+}
+
+// TickMessage contains confirmed inputs of all players for tick
+type TickMessage struct {
+	MsgType uint8
+	Tick    uint64
+	Inputs  []PlayerInput
+}
+
+// GetMessageType return message type
+func (m *TickMessage) GetMessageType() uint8 {
+	return m.MsgType
+}
+
+// Encode encode message as: type | tick | players count | (player id | commands count | (length | command)...)...
+func (m *TickMessage) Encode() []byte {
+	buf := []byte{m.MsgType}
+	buf = binary.AppendUvarint(buf, m.Tick)
+	buf = binary.AppendUvarint(buf, uint64(len(m.Inputs)))
+
+	for _, in := range m.Inputs {
+		buf = binary.AppendUvarint(buf, in.PlayerID)
+		buf = binary.AppendUvarint(buf, uint64(len(in.Commands)))
+
+		for _, cmd := range in.Commands {
+			data := cmd.Encode()
+			buf = binary.AppendUvarint(buf, uint64(len(data)))
+			buf = append(buf, data...)
+		}
+	}
+
+	return buf
+}
+
+// DecodeTickMessage decode tick message, commands parsed by given parser
+func DecodeTickMessage(data []byte, parser communications.CommandParser) (*TickMessage, error) {
+	if len(data) == 0 {
+		return nil, ErrMalformedMessage
+	}
+
+	m := &TickMessage{MsgType: data[0]}
+	data = data[1:]
+
+	var err error
+
+	m.Tick, data, err = readUvarint(data)
+	if err != nil {
+		return nil, err
+	}
+
+	players, data, err := readUvarint(data)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := uint64(0); i < players; i++ {
+		var in PlayerInput
+		var count uint64
+
+		in.PlayerID, data, err = readUvarint(data)
+		if err != nil {
+			return nil, err
+		}
+
+		count, data, err = readUvarint(data)
+		if err != nil {
+			return nil, err
+		}
+
+		for j := uint64(0); j < count; j++ {
+			var size uint64
+
+			size, data, err = readUvarint(data)
+			if err != nil {
+				return nil, err
+			}
+
+			if uint64(len(data)) < size {
+				return nil, ErrMalformedMessage
+			}
+
+			cmd, err := parser.Parse(data[:size])
+			if err != nil {
+				return nil, err
+			}
+
+			in.Commands = append(in.Commands, cmd)
+			data = data[size:]
+		}
+
+		m.Inputs = append(m.Inputs, in)
+	}
+
+	return m, nil
+}
+
+func readUvarint(data []byte) (uint64, []byte, error) {
+	v, n := binary.Uvarint(data)
+	if n <= 0 {
+		return 0, nil, ErrMalformedMessage
+	}
+
+	return v, data[n:], nil
+}
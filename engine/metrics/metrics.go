@@ -0,0 +1,107 @@
+package metrics
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// Label describe metric label
+type Label struct {
+	Name  string
+	Value string
+}
+
+// L return new label
+func L(name, value string) Label {
+	return Label{Name: name, Value: value}
+}
+
+// Metrics describe metrics collector
+type Metrics interface {
+	AddCounter(name string, value float64, labels ...Label)
+	SetGauge(name string, value float64, labels ...Label)
+	Observe(name string, value float64, labels ...Label)
+}
+
+// Span describe single traced operation
+type Span interface {
+	SetAttribute(key string, value any)
+	End(err error)
+}
+
+// Tracer describe tracing backend
+type Tracer interface {
+	StartSpan(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Nop metrics and tracer which do nothing
+type Nop struct{}
+
+// AddCounter do nothing
+func (Nop) AddCounter(string, float64, ...Label) {}
+
+// SetGauge do nothing
+func (Nop) SetGauge(string, float64, ...Label) {}
+
+// Observe do nothing
+func (Nop) Observe(string, float64, ...Label) {}
+
+// StartSpan return nop span
+func (Nop) StartSpan(ctx context.Context, _ string) (context.Context, Span) {
+	return ctx, Nop{}
+}
+
+// SetAttribute do nothing
+func (Nop) SetAttribute(string, any) {}
+
+// End do nothing
+func (Nop) End(error) {}
+
+type metricsHolder struct {
+	Metrics
+}
+
+type tracerHolder struct {
+	Tracer
+}
+
+var (
+	defaultMetrics atomic.Value
+	defaultTracer  atomic.Value
+)
+
+// SetDefault set metrics used by engine systems without own metrics
+func SetDefault(m Metrics) {
+	if m == nil {
+		m = Nop{}
+	}
+
+	defaultMetrics.Store(metricsHolder{m})
+}
+
+// Default return default metrics, nop if not set
+func Default() Metrics {
+	if h, ok := defaultMetrics.Load().(metricsHolder); ok {
+		return h.Metrics
+	}
+
+	return Nop{}
+}
+
+// SetDefaultTracer set tracer used by engine systems without own tracer
+func SetDefaultTracer(t Tracer) {
+	if t == nil {
+		t = Nop{}
+	}
+
+	defaultTracer.Store(tracerHolder{t})
+}
+
+// DefaultTracer return default tracer, nop if not set
+func DefaultTracer() Tracer {
+	if h, ok := defaultTracer.Load().(tracerHolder); ok {
+		return h.Tracer
+	}
+
+	return Nop{}
+}
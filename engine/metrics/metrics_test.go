@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/InsideGallery/core/testutils"
+)
+
+func TestPrometheus(t *testing.T) {
+	p := NewPrometheus("game", 0.1, 1)
+	p.AddCounter("commands_total", 1, L("type", "1"))
+	p.AddCounter("commands_total", 2, L("type", "1"))
+	p.AddCounter("commands_total", 1, L("type", "a\"b"))
+	p.SetGauge("queue depth", 5)
+	p.Observe("tick_seconds", 0.05)
+	p.Observe("tick_seconds", 0.5)
+	p.Observe("tick_seconds", 2)
+	p.SetGauge("tick_seconds", 1) // kind conflict ignored
+
+	buf := &bytes.Buffer{}
+	_, err := p.WriteTo(buf)
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, buf.String(), strings.Join([]string{
+		"# TYPE game_commands_total counter",
+		`game_commands_total{type="1"} 3`,
+		`game_commands_total{type="a\"b"} 1`,
+		"# TYPE game_queue_depth gauge",
+		"game_queue_depth 5",
+		"# TYPE game_tick_seconds histogram",
+		`game_tick_seconds_bucket{le="0.1"} 1`,
+		`game_tick_seconds_bucket{le="1"} 2`,
+		`game_tick_seconds_bucket{le="+Inf"} 3`,
+		"game_tick_seconds_sum 2.55",
+		"game_tick_seconds_count 3",
+		"",
+	}, "\n"))
+}
+
+func TestDefaults(t *testing.T) {
+	testutils.Equal(t, Default(), Metrics(Nop{}))
+	testutils.Equal(t, DefaultTracer(), Tracer(Nop{}))
+
+	p := NewPrometheus("")
+	SetDefault(p)
+	SetDefaultTracer(NewMetricsTracer(p))
+	defer SetDefault(nil)
+	defer SetDefaultTracer(nil)
+
+	_, span := DefaultTracer().StartSpan(context.Background(), "test")
+	span.SetAttribute("key", 1)
+	span.End(errors.New("test"))
+
+	buf := &bytes.Buffer{}
+	_, err := Default().(*Prometheus).WriteTo(buf)
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, strings.Contains(buf.String(), `span_duration_seconds_count{span="test",status="error"} 1`), true)
+}
@@ -0,0 +1,262 @@
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultBuckets default histogram buckets in seconds
+var DefaultBuckets = []float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5}
+
+const (
+	kindCounter   = "counter"
+	kindGauge     = "gauge"
+	kindHistogram = "histogram"
+)
+
+type series struct {
+	labels string
+	value  float64
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+type family struct {
+	kind   string
+	series map[string]*series
+}
+
+// Prometheus collect metrics in memory and expose them in prometheus text format
+type Prometheus struct {
+	namespace string
+	buckets   []float64
+	families  map[string]*family
+
+	mu sync.Mutex
+}
+
+// NewPrometheus return new prometheus metrics, DefaultBuckets used if buckets not given
+func NewPrometheus(namespace string, buckets ...float64) *Prometheus {
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+
+	b := make([]float64, len(buckets))
+	copy(b, buckets)
+	sort.Float64s(b)
+
+	return &Prometheus{
+		namespace: namespace,
+		buckets:   b,
+		families:  make(map[string]*family),
+	}
+}
+
+// AddCounter add value to counter
+func (p *Prometheus) AddCounter(name string, value float64, labels ...Label) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if s := p.series(name, kindCounter, labels); s != nil {
+		s.value += value
+	}
+}
+
+// SetGauge set gauge value
+func (p *Prometheus) SetGauge(name string, value float64, labels ...Label) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if s := p.series(name, kindGauge, labels); s != nil {
+		s.value = value
+	}
+}
+
+// Observe add observation to histogram
+func (p *Prometheus) Observe(name string, value float64, labels ...Label) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	s := p.series(name, kindHistogram, labels)
+	if s == nil {
+		return
+	}
+
+	if s.counts == nil {
+		s.counts = make([]uint64, len(p.buckets))
+	}
+
+	for i, b := range p.buckets {
+		if value <= b {
+			s.counts[i]++
+		}
+	}
+
+	s.sum += value
+	s.count++
+}
+
+// WriteTo write all metrics in prometheus text exposition format
+func (p *Prometheus) WriteTo(w io.Writer) (int64, error) {
+	p.mu.Lock()
+	buf := &bytes.Buffer{}
+
+	names := make([]string, 0, len(p.families))
+	for name := range p.families {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		f := p.families[name]
+		fmt.Fprintf(buf, "# TYPE %s %s\n", name, f.kind)
+
+		keys := make([]string, 0, len(f.series))
+		for k := range f.series {
+			keys = append(keys, k)
+		}
+
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			p.writeSeries(buf, name, f.kind, f.series[k])
+		}
+	}
+	p.mu.Unlock()
+
+	return buf.WriteTo(w)
+}
+
+// ServeHTTP serve metrics endpoint
+func (p *Prometheus) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+
+	_, _ = p.WriteTo(w)
+}
+
+func (p *Prometheus) writeSeries(buf *bytes.Buffer, name, kind string, s *series) {
+	if kind != kindHistogram {
+		fmt.Fprintf(buf, "%s%s %s\n", name, wrapLabels(s.labels), formatFloat(s.value))
+		return
+	}
+
+	for i, b := range p.buckets {
+		fmt.Fprintf(buf, "%s_bucket%s %d\n", name, wrapLabels(joinLabels(s.labels, "le", formatFloat(b))), s.counts[i])
+	}
+
+	fmt.Fprintf(buf, "%s_bucket%s %d\n", name, wrapLabels(joinLabels(s.labels, "le", "+Inf")), s.count)
+	fmt.Fprintf(buf, "%s_sum%s %s\n", name, wrapLabels(s.labels), formatFloat(s.sum))
+	fmt.Fprintf(buf, "%s_count%s %d\n", name, wrapLabels(s.labels), s.count)
+}
+
+// series return series for name and labels, nil if name already used by other kind
+func (p *Prometheus) series(name, kind string, labels []Label) *series {
+	name = p.fullName(name)
+
+	f, exists := p.families[name]
+	if !exists {
+		f = &family{
+			kind:   kind,
+			series: make(map[string]*series),
+		}
+		p.families[name] = f
+	}
+
+	if f.kind != kind {
+		return nil
+	}
+
+	key := encodeLabels(labels)
+
+	s, exists := f.series[key]
+	if !exists {
+		s = &series{labels: key}
+		f.series[key] = s
+	}
+
+	return s
+}
+
+func (p *Prometheus) fullName(name string) string {
+	if p.namespace == "" {
+		return sanitize(name)
+	}
+
+	return sanitize(p.namespace + "_" + name)
+}
+
+// encodeLabels return labels sorted by name in prometheus format without braces
+func encodeLabels(labels []Label) string {
+	if len(labels) == 0 {
+		return ""
+	}
+
+	sorted := make([]Label, len(labels))
+	copy(sorted, labels)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	parts := make([]string, len(sorted))
+	for i, l := range sorted {
+		parts[i] = sanitize(l.Name) + `="` + escape(l.Value) + `"`
+	}
+
+	return strings.Join(parts, ",")
+}
+
+func joinLabels(labels, name, value string) string {
+	l := name + `="` + value + `"`
+	if labels == "" {
+		return l
+	}
+
+	return labels + "," + l
+}
+
+func wrapLabels(labels string) string {
+	if labels == "" {
+		return ""
+	}
+
+	return "{" + labels + "}"
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+var escaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escape(v string) string {
+	return escaper.Replace(v)
+}
+
+// sanitize replace characters not allowed in metric names
+func sanitize(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || r == ':' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+
+		return '_'
+	}, name)
+}
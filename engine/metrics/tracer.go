@@ -0,0 +1,49 @@
+package metrics
+
+import (
+	"context"
+	"time"
+)
+
+// SpanDurationMetric histogram of span durations reported by MetricsTracer
+const SpanDurationMetric = "span_duration_seconds"
+
+// MetricsTracer report spans as duration histogram labeled by span name and status
+type MetricsTracer struct {
+	metrics Metrics
+}
+
+// NewMetricsTracer return tracer which report spans into given metrics
+func NewMetricsTracer(m Metrics) *MetricsTracer {
+	return &MetricsTracer{
+		metrics: m,
+	}
+}
+
+// StartSpan start new span
+func (t *MetricsTracer) StartSpan(ctx context.Context, name string) (context.Context, Span) {
+	return ctx, &metricsSpan{
+		metrics: t.metrics,
+		name:    name,
+		start:   time.Now(),
+	}
+}
+
+type metricsSpan struct {
+	metrics Metrics
+	name    string
+	start   time.Time
+}
+
+// SetAttribute attributes are not reported as labels to keep cardinality low
+func (s *metricsSpan) SetAttribute(string, any) {}
+
+// End report span duration
+func (s *metricsSpan) End(err error) {
+	status := "ok"
+	if err != nil {
+		status = "error"
+	}
+
+	s.metrics.Observe(SpanDurationMetric, time.Since(s.start).Seconds(), L("span", s.name), L("status", status))
+}
@@ -1,10 +1,17 @@
 package names
 
-import "sync"
+import (
+	"sync"
+
+	"github.com/InsideGallery/game-core/engine/localization"
+)
 
 // NameComponent helper for naming entities
 type NameComponent struct {
-	name string
+	name         string
+	displayNames map[string]string // locale -> display name
+	registry     *Registry
+	entityID     uint64
 
 	mu sync.RWMutex
 }
@@ -16,12 +23,19 @@ func NewNameComponent(name string) *NameComponent {
 	}
 }
 
-// SetName set name
-func (n *NameComponent) SetName(name string) {
-	n.mu.Lock()
-	defer n.mu.Unlock()
+// SetName set name, validated by registry if component is registered, name is kept if registry rejects it
+func (n *NameComponent) SetName(name string) error {
+	n.mu.RLock()
+	registry, id := n.registry, n.entityID
+	n.mu.RUnlock()
 
-	n.name = name
+	if registry != nil {
+		return registry.rename(id, n, name)
+	}
+
+	n.set(name)
+
+	return nil
 }
 
 // GetName get name
@@ -33,3 +47,65 @@ func (n *NameComponent) GetName() string {
 
 	return name
 }
+
+// SetDisplayName set display name for locale
+func (n *NameComponent) SetDisplayName(locale, name string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.displayNames == nil {
+		n.displayNames = make(map[string]string)
+	}
+
+	n.displayNames[localization.NormalizeLocale(locale)] = name
+}
+
+// RemoveDisplayName remove display name for locale
+func (n *NameComponent) RemoveDisplayName(locale string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	delete(n.displayNames, localization.NormalizeLocale(locale))
+}
+
+// GetDisplayName return display name for locale, its base language or name
+func (n *NameComponent) GetDisplayName(locale string) string {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	for _, l := range localization.Fallbacks(locale, "") {
+		if name, exists := n.displayNames[l]; exists {
+			return name
+		}
+	}
+
+	name := n.name
+
+	return name
+}
+
+// Destroy release name in registry, called by entity registry when entity removed
+func (n *NameComponent) Destroy() error {
+	n.mu.RLock()
+	registry, id := n.registry, n.entityID
+	n.mu.RUnlock()
+
+	if registry != nil {
+		registry.Unregister(id)
+	}
+
+	return nil
+}
+
+func (n *NameComponent) set(name string) {
+	n.mu.Lock()
+	n.name = name
+	n.mu.Unlock()
+}
+
+func (n *NameComponent) attach(registry *Registry, entityID uint64) {
+	n.mu.Lock()
+	n.registry = registry
+	n.entityID = entityID
+	n.mu.Unlock()
+}
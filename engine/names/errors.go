@@ -0,0 +1,11 @@
+package names
+
+import "errors"
+
+// Names errors
+var (
+	ErrEmptyName         = errors.New("empty name")
+	ErrNameTaken         = errors.New("name already taken")
+	ErrNameReserved      = errors.New("name is reserved")
+	ErrAlreadyRegistered = errors.New("entity already registered")
+)
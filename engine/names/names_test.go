@@ -3,12 +3,92 @@ package names
 import (
 	"testing"
 
+	"github.com/InsideGallery/core/ecs"
+	"github.com/InsideGallery/core/memory/registry"
 	"github.com/InsideGallery/core/testutils"
 )
 
 func TestNamesComponent(t *testing.T) {
 	n := NewNameComponent("test")
 	testutils.Equal(t, n.GetName(), "test")
-	n.SetName("abc")
+	testutils.Equal(t, n.SetName("abc"), nil)
 	testutils.Equal(t, n.GetName(), "abc")
 }
+
+func TestNamesRegistry(t *testing.T) {
+	r := NewRegistry("Admin")
+	n1 := NewNameComponent("Alice")
+	n2 := NewNameComponent("alice")
+	n3 := NewNameComponent("admin")
+
+	testutils.Equal(t, r.Register(1, n1), nil)
+	testutils.Equal(t, r.Register(1, n1), ErrAlreadyRegistered)
+	testutils.Equal(t, r.Register(2, n2), ErrNameTaken)
+	testutils.Equal(t, r.Register(3, n3), ErrNameReserved)
+	testutils.Equal(t, r.IsReserved("ADMIN"), true)
+
+	testutils.Equal(t, n2.SetName("Alina"), nil)
+	testutils.Equal(t, r.Register(2, n2), nil)
+	testutils.Equal(t, n2.SetName("ALICE"), ErrNameTaken)
+	testutils.Equal(t, n2.GetName(), "Alina")
+	testutils.Equal(t, n2.SetName(""), ErrEmptyName)
+	testutils.Equal(t, n1.SetName("ALICE"), nil)
+
+	id, exists := r.Lookup("alice")
+	testutils.Equal(t, exists, true)
+	testutils.Equal(t, id, uint64(1))
+
+	testutils.Equal(t, n3.SetName("Bob"), nil)
+	testutils.Equal(t, r.Register(3, n3), nil)
+	testutils.Equal(t, r.Search("al", 0), []string{"ALICE", "Alina"})
+	testutils.Equal(t, r.Search("AL", 1), []string{"ALICE"})
+	testutils.Equal(t, r.Search("x", 0), []string{})
+
+	testutils.Equal(t, n1.Destroy(), nil)
+	testutils.Equal(t, r.IsAvailable("alice"), true)
+	testutils.Equal(t, r.Size(), 2)
+	testutils.Equal(t, n1.SetName("Alina"), nil)
+
+	testutils.Equal(t, n2.SetName("Bob"), ErrNameTaken)
+	testutils.Equal(t, n2.GetName(), "Alina")
+
+	// rename racing with unregister does not return name to registry
+	r.Unregister(2)
+	testutils.Equal(t, r.rename(2, n2, "Zed"), nil)
+	testutils.Equal(t, n2.GetName(), "Zed")
+	testutils.Equal(t, r.Search("z", 0), []string{})
+	testutils.Equal(t, r.IsAvailable("zed"), true)
+}
+
+type namedEntity struct {
+	*ecs.BaseEntity
+	*NameComponent
+}
+
+func TestNameReleasedOnDestroy(t *testing.T) {
+	names := NewRegistry()
+	entities := registry.NewRegistry[any, any, any]()
+
+	e := &namedEntity{BaseEntity: ecs.NewBaseEntityWithID(1), NameComponent: NewNameComponent("Alice")}
+	testutils.Equal(t, names.Register(e.GetID(), e.NameComponent), nil)
+	testutils.Equal(t, entities.Add("players", e.GetID(), e), nil)
+	testutils.Equal(t, names.IsAvailable("alice"), false)
+
+	testutils.Equal(t, entities.Remove("players", e.GetID()), nil)
+	testutils.Equal(t, names.IsAvailable("alice"), true)
+	testutils.Equal(t, names.Size(), 0)
+}
+
+func TestDisplayName(t *testing.T) {
+	n := NewNameComponent("Dragon")
+	n.SetDisplayName("uk", "Дракон")
+	n.SetDisplayName("de-AT", "Drache")
+
+	testutils.Equal(t, n.GetDisplayName("uk_UA"), "Дракон")
+	testutils.Equal(t, n.GetDisplayName("DE-at"), "Drache")
+	testutils.Equal(t, n.GetDisplayName("de"), "Dragon")
+	testutils.Equal(t, n.GetDisplayName("fr"), "Dragon")
+
+	n.RemoveDisplayName("uk")
+	testutils.Equal(t, n.GetDisplayName("uk"), "Dragon")
+}
@@ -0,0 +1,201 @@
+package names
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Normalize return case-insensitive form of name used as registry key
+func Normalize(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// Registry index names of entities and keep them unique
+type Registry struct {
+	ids      map[string]uint64         // normalized name -> entity id
+	names    map[uint64]string         // entity id -> normalized name
+	comps    map[uint64]*NameComponent // entity id -> component
+	reserved map[string]struct{}
+	sorted   []string // sorted normalized names for prefix search
+
+	mu sync.RWMutex
+}
+
+// NewRegistry return new name registry with reserved names
+func NewRegistry(reserved ...string) *Registry {
+	r := &Registry{
+		ids:      make(map[string]uint64),
+		names:    make(map[uint64]string),
+		comps:    make(map[uint64]*NameComponent),
+		reserved: make(map[string]struct{}),
+	}
+	r.Reserve(reserved...)
+
+	return r
+}
+
+// Reserve add names which can't be used by entities
+func (r *Registry) Reserve(names ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, name := range names {
+		r.reserved[Normalize(name)] = struct{}{}
+	}
+}
+
+// IsReserved return true if name is reserved
+func (r *Registry) IsReserved(name string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, exists := r.reserved[Normalize(name)]
+
+	return exists
+}
+
+// Register index name of component for entity, component changes validated by registry after that
+func (r *Registry) Register(entityID uint64, n *NameComponent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.comps[entityID]; exists {
+		return ErrAlreadyRegistered
+	}
+
+	key := Normalize(n.GetName())
+	if err := r.validate(entityID, key); err != nil {
+		return err
+	}
+
+	r.add(entityID, key)
+	r.comps[entityID] = n
+	n.attach(r, entityID)
+
+	return nil
+}
+
+// Unregister release name of entity
+func (r *Registry) Unregister(entityID uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	n, exists := r.comps[entityID]
+	if !exists {
+		return
+	}
+
+	r.remove(entityID)
+	delete(r.comps, entityID)
+	n.attach(nil, 0)
+}
+
+// Lookup return entity id by name, case-insensitive
+func (r *Registry) Lookup(name string) (uint64, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	id, exists := r.ids[Normalize(name)]
+
+	return id, exists
+}
+
+// IsAvailable return true if name could be taken
+func (r *Registry) IsAvailable(name string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.validate(0, Normalize(name)) == nil
+}
+
+// Search return up to limit names which start with prefix, case-insensitive (limit <= 0 - unlimited)
+func (r *Registry) Search(prefix string, limit int) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	prefix = Normalize(prefix)
+	result := []string{}
+
+	for i := sort.SearchStrings(r.sorted, prefix); i < len(r.sorted); i++ {
+		key := r.sorted[i]
+		if !strings.HasPrefix(key, prefix) || (limit > 0 && len(result) >= limit) {
+			break
+		}
+
+		result = append(result, r.comps[r.ids[key]].GetName())
+	}
+
+	return result
+}
+
+// Size return count of registered names
+func (r *Registry) Size() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.sorted)
+}
+
+// rename atomically check and change name of entity, component unregistered concurrently is renamed without registry
+func (r *Registry) rename(entityID uint64, n *NameComponent, name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.comps[entityID] != n {
+		n.set(name)
+		return nil
+	}
+
+	key := Normalize(name)
+	if err := r.validate(entityID, key); err != nil {
+		return err
+	}
+
+	r.remove(entityID)
+	r.add(entityID, key)
+	n.set(name)
+
+	return nil
+}
+
+func (r *Registry) validate(entityID uint64, key string) error {
+	if key == "" {
+		return ErrEmptyName
+	}
+
+	if _, exists := r.reserved[key]; exists {
+		return ErrNameReserved
+	}
+
+	if id, exists := r.ids[key]; exists && id != entityID {
+		return ErrNameTaken
+	}
+
+	return nil
+}
+
+func (r *Registry) add(entityID uint64, key string) {
+	r.ids[key] = entityID
+	r.names[entityID] = key
+
+	i := sort.SearchStrings(r.sorted, key)
+	r.sorted = append(r.sorted, "")
+	copy(r.sorted[i+1:], r.sorted[i:])
+	r.sorted[i] = key
+}
+
+func (r *Registry) remove(entityID uint64) {
+	key, exists := r.names[entityID]
+	if !exists {
+		return
+	}
+
+	delete(r.ids, key)
+	delete(r.names, entityID)
+
+	i := sort.SearchStrings(r.sorted, key)
+	if i < len(r.sorted) && r.sorted[i] == key {
+		r.sorted = append(r.sorted[:i], r.sorted[i+1:]...)
+	}
+}
@@ -0,0 +1,33 @@
+package replay
+
+import (
+	"errors"
+	"fmt"
+)
+
+// All kind of errors for replay
+var (
+	ErrInvalidHeader    = errors.New("invalid replay header")
+	ErrUnknownEntry     = errors.New("unknown replay entry")
+	ErrTickInPast       = errors.New("entry tick is older than current tick")
+	ErrDivergence       = errors.New("replay diverged")
+	ErrNotChecksummable = errors.New("game does not implement checksummer")
+	ErrMsgTypeMismatch  = errors.New("replayed command has different message type")
+)
+
+// DivergenceError describe checksum mismatch at given tick
+type DivergenceError struct {
+	Tick     uint64
+	Expected uint64
+	Actual   uint64
+}
+
+// Error return error message
+func (e *DivergenceError) Error() string {
+	return fmt.Sprintf("replay diverged at tick %d: expected checksum %x, got %x", e.Tick, e.Expected, e.Actual)
+}
+
+// Unwrap return base error
+func (e *DivergenceError) Unwrap() error {
+	return ErrDivergence
+}
@@ -0,0 +1,180 @@
+package replay
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// Replay file format:
+//
+//	header: magic "GREC" | version (1 byte)
+//	entry:  kind (1 byte) | tick (uvarint) | body
+//	body for command:  message type (1 byte) | length (uvarint) | payload
+//	body for checksum: checksum (8 bytes, little endian)
+
+const version = 1
+
+var magic = []byte("GREC")
+
+// EntryKind describe kind of replay entry
+type EntryKind uint8
+
+// Replay entry kinds
+const (
+	EntryCommand EntryKind = iota + 1
+	EntryChecksum
+)
+
+// Entry describe single record of replay
+type Entry struct {
+	Kind     EntryKind
+	Tick     uint64
+	MsgType  uint8
+	Payload  []byte
+	Checksum uint64
+}
+
+// Writer write replay entries in compact binary format
+type Writer struct {
+	w   *bufio.Writer
+	buf [binary.MaxVarintLen64]byte
+}
+
+// NewWriter return new writer and write header
+func NewWriter(w io.Writer) (*Writer, error) {
+	bw := bufio.NewWriter(w)
+
+	if _, err := bw.Write(magic); err != nil {
+		return nil, err
+	}
+
+	if err := bw.WriteByte(version); err != nil {
+		return nil, err
+	}
+
+	return &Writer{w: bw}, nil
+}
+
+// Write write single entry
+func (w *Writer) Write(e Entry) error {
+	if err := w.w.WriteByte(byte(e.Kind)); err != nil {
+		return err
+	}
+
+	if err := w.writeUvarint(e.Tick); err != nil {
+		return err
+	}
+
+	switch e.Kind {
+	case EntryCommand:
+		if err := w.w.WriteByte(e.MsgType); err != nil {
+			return err
+		}
+
+		if err := w.writeUvarint(uint64(len(e.Payload))); err != nil {
+			return err
+		}
+
+		_, err := w.w.Write(e.Payload)
+
+		return err
+	case EntryChecksum:
+		binary.LittleEndian.PutUint64(w.buf[:8], e.Checksum)
+		_, err := w.w.Write(w.buf[:8])
+
+		return err
+	default:
+		return ErrUnknownEntry
+	}
+}
+
+// Flush flush buffered data
+func (w *Writer) Flush() error {
+	return w.w.Flush()
+}
+
+func (w *Writer) writeUvarint(v uint64) error {
+	n := binary.PutUvarint(w.buf[:], v)
+	_, err := w.w.Write(w.buf[:n])
+
+	return err
+}
+
+// Reader read replay entries
+type Reader struct {
+	r *bufio.Reader
+}
+
+// NewReader return new reader and validate header
+func NewReader(r io.Reader) (*Reader, error) {
+	br := bufio.NewReader(r)
+
+	header := make([]byte, len(magic)+1)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return nil, ErrInvalidHeader
+	}
+
+	if !bytes.Equal(header[:len(magic)], magic) || header[len(magic)] != version {
+		return nil, ErrInvalidHeader
+	}
+
+	return &Reader{r: br}, nil
+}
+
+// Next return next entry, io.EOF when replay is over
+func (r *Reader) Next() (e Entry, err error) {
+	kind, err := r.r.ReadByte()
+	if err != nil {
+		return e, err
+	}
+
+	e.Kind = EntryKind(kind)
+
+	e.Tick, err = binary.ReadUvarint(r.r)
+	if err != nil {
+		return e, unexpected(err)
+	}
+
+	switch e.Kind {
+	case EntryCommand:
+		e.MsgType, err = r.r.ReadByte()
+		if err != nil {
+			return e, unexpected(err)
+		}
+
+		var size uint64
+
+		size, err = binary.ReadUvarint(r.r)
+		if err != nil {
+			return e, unexpected(err)
+		}
+
+		e.Payload = make([]byte, size)
+		if _, err = io.ReadFull(r.r, e.Payload); err != nil {
+			return e, unexpected(err)
+		}
+	case EntryChecksum:
+		var sum [8]byte
+		if _, err = io.ReadFull(r.r, sum[:]); err != nil {
+			return e, unexpected(err)
+		}
+
+		e.Checksum = binary.LittleEndian.Uint64(sum[:])
+	default:
+		return e, ErrUnknownEntry
+	}
+
+	return e, nil
+}
+
+// unexpected convert EOF in the middle of entry to io.ErrUnexpectedEOF
+func unexpected(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+
+	return err
+}
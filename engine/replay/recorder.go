@@ -0,0 +1,139 @@
+package replay
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/InsideGallery/game-core/engine"
+	"github.com/InsideGallery/game-core/engine/communications"
+)
+
+// Recorder record incoming commands and periodic state checksums
+type Recorder struct {
+	writer   *Writer
+	state    engine.Checksummer
+	interval uint64
+	tick     uint64
+
+	mu sync.Mutex
+}
+
+// NewRecorder return new recorder, checksum of state written every interval ticks (0 - disabled)
+func NewRecorder(w io.Writer, state engine.Checksummer, interval uint64) (*Recorder, error) {
+	writer, err := NewWriter(w)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Recorder{
+		writer:   writer,
+		state:    state,
+		interval: interval,
+	}, nil
+}
+
+// Tick return current tick
+func (r *Recorder) Tick() uint64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tick := r.tick
+
+	return tick
+}
+
+// Record record raw message of command at current tick, message is passed to parser as is on replay
+func (r *Recorder) Record(msgType uint8, msg []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.writer.Write(Entry{
+		Kind:    EntryCommand,
+		Tick:    r.tick,
+		MsgType: msgType,
+		Payload: msg,
+	})
+}
+
+// EndTick finish current tick, write checksum if required
+func (r *Recorder) EndTick() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tick := r.tick
+	r.tick++
+
+	if r.state == nil || r.interval == 0 || tick%r.interval != 0 {
+		return nil
+	}
+
+	return r.writer.Write(Entry{
+		Kind:     EntryChecksum,
+		Tick:     tick,
+		Checksum: r.state.Checksum(),
+	})
+}
+
+// Flush flush recorded data
+func (r *Recorder) Flush() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.writer.Flush()
+}
+
+// RecordingParser record every parsed command
+type RecordingParser struct {
+	parser   communications.CommandParser
+	recorder *Recorder
+}
+
+// NewRecordingParser return parser which record commands of given parser
+func NewRecordingParser(parser communications.CommandParser, recorder *Recorder) *RecordingParser {
+	return &RecordingParser{
+		parser:   parser,
+		recorder: recorder,
+	}
+}
+
+// Parse parse command and record raw message
+func (p *RecordingParser) Parse(msg []byte) (communications.Command, error) {
+	cmd, err := p.parser.Parse(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.recorder.Record(cmd.GetMsgType(), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return cmd, nil
+}
+
+// RecordingGame finish recorder tick after each game tick
+type RecordingGame struct {
+	engine.Game
+	recorder *Recorder
+	onError  func(err error)
+}
+
+// NewRecordingGame return game which record ticks of given game
+func NewRecordingGame(game engine.Game, recorder *Recorder, onError func(err error)) *RecordingGame {
+	return &RecordingGame{
+		Game:     game,
+		recorder: recorder,
+		onError:  onError,
+	}
+}
+
+// Tick tick game and finish recorder tick
+func (g *RecordingGame) Tick(ctx context.Context) {
+	g.Game.Tick(ctx)
+
+	err := g.recorder.EndTick()
+	if err != nil && g.onError != nil {
+		g.onError(err)
+	}
+}
@@ -0,0 +1,205 @@
+package replay
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/InsideGallery/game-core/engine/communications"
+
+	"github.com/InsideGallery/core/testutils"
+)
+
+type counterGame struct {
+	value int64
+	ticks uint64
+}
+
+func (g *counterGame) Initialize() error { return nil }
+
+func (g *counterGame) Tick(_ context.Context) {
+	g.ticks++
+	g.value *= 2
+}
+
+func (g *counterGame) Checksum() uint64 {
+	return uint64(g.value)
+}
+
+type addCommand struct {
+	game  *counterGame
+	value byte
+}
+
+func (c *addCommand) GetMsgType() uint8 { return 1 }
+
+func (c *addCommand) Decode(msg []byte) { c.value = msg[0] }
+
+func (c *addCommand) Encode() []byte { return []byte{c.value} }
+
+func (c *addCommand) Execute(_ context.Context) error {
+	c.game.value += int64(c.value)
+	return nil
+}
+
+type addParser struct {
+	game *counterGame
+}
+
+func (p *addParser) Parse(msg []byte) (cmd communications.Command, err error) {
+	c := &addCommand{game: p.game}
+	c.Decode(msg)
+
+	return c, nil
+}
+
+func record(t *testing.T, inputs map[uint64][]byte, ticks uint64) []byte {
+	t.Helper()
+
+	ctx := context.Background()
+	buf := &bytes.Buffer{}
+	game := &counterGame{}
+
+	rec, err := NewRecorder(buf, game, 2)
+	testutils.Equal(t, err, nil)
+
+	parser := NewRecordingParser(&addParser{game: game}, rec)
+	g := NewRecordingGame(game, rec, func(err error) { t.Fatal(err) })
+
+	for i := uint64(0); i < ticks; i++ {
+		for _, v := range inputs[i] {
+			cmd, err := parser.Parse([]byte{v})
+			testutils.Equal(t, err, nil)
+			testutils.Equal(t, cmd.Execute(ctx), nil)
+		}
+		g.Tick(ctx)
+	}
+
+	testutils.Equal(t, rec.Tick(), ticks)
+	testutils.Equal(t, rec.Flush(), nil)
+
+	return buf.Bytes()
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	inputs := map[uint64][]byte{0: {1}, 3: {2, 5}, 4: {7}}
+	data := record(t, inputs, 6)
+
+	r, err := NewReader(bytes.NewReader(data))
+	testutils.Equal(t, err, nil)
+
+	var commands, checksums int
+	for {
+		e, err := r.Next()
+		if err != nil {
+			break
+		}
+
+		switch e.Kind {
+		case EntryCommand:
+			commands++
+		case EntryChecksum:
+			checksums++
+		}
+	}
+	testutils.Equal(t, commands, 4)
+	testutils.Equal(t, checksums, 3)
+
+	game := &counterGame{}
+	p, err := NewReplayer(bytes.NewReader(data), game, &addParser{game: game})
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, p.Run(context.Background()), nil)
+	testutils.Equal(t, game.ticks, uint64(5))
+}
+
+func TestReplayDivergence(t *testing.T) {
+	data := record(t, map[uint64][]byte{1: {3}}, 5)
+
+	game := &counterGame{value: 1}
+	p, err := NewReplayer(bytes.NewReader(data), game, &addParser{game: game})
+	testutils.Equal(t, err, nil)
+
+	err = p.Run(context.Background())
+	testutils.Equal(t, errors.Is(err, ErrDivergence), true)
+
+	var divergence *DivergenceError
+	testutils.Equal(t, errors.As(err, &divergence), true)
+	testutils.Equal(t, divergence.Tick, uint64(0))
+}
+
+func TestInvalidHeader(t *testing.T) {
+	_, err := NewReader(bytes.NewReader([]byte("test")))
+	testutils.Equal(t, err, ErrInvalidHeader)
+}
+
+type doubleCommand struct {
+	game *counterGame
+}
+
+func (c *doubleCommand) GetMsgType() uint8 { return 2 }
+
+func (c *doubleCommand) Decode(_ []byte) {}
+
+func (c *doubleCommand) Encode() []byte { return nil }
+
+func (c *doubleCommand) Execute(_ context.Context) error {
+	c.game.value *= 2
+	return nil
+}
+
+var errUnknownCommand = errors.New("unknown command")
+
+// typedParser dispatch on type byte of message, payload follows it
+type typedParser struct {
+	game *counterGame
+}
+
+func (p *typedParser) Parse(msg []byte) (communications.Command, error) {
+	if len(msg) == 0 {
+		return nil, errUnknownCommand
+	}
+
+	var cmd communications.Command
+
+	switch msg[0] {
+	case 1:
+		cmd = &addCommand{game: p.game}
+	case 2:
+		cmd = &doubleCommand{game: p.game}
+	default:
+		return nil, errUnknownCommand
+	}
+
+	cmd.Decode(msg[1:])
+
+	return cmd, nil
+}
+
+func TestReplayRawMessages(t *testing.T) {
+	ctx := context.Background()
+	buf := &bytes.Buffer{}
+	game := &counterGame{}
+
+	rec, err := NewRecorder(buf, game, 1)
+	testutils.Equal(t, err, nil)
+
+	parser := NewRecordingParser(&typedParser{game: game}, rec)
+	g := NewRecordingGame(game, rec, func(err error) { t.Fatal(err) })
+
+	for _, msg := range [][]byte{{1, 3}, {2}, {1, 4}} {
+		cmd, err := parser.Parse(msg)
+		testutils.Equal(t, err, nil)
+		testutils.Equal(t, cmd.Execute(ctx), nil)
+		g.Tick(ctx)
+	}
+
+	testutils.Equal(t, rec.Flush(), nil)
+	testutils.Equal(t, game.value, int64(56))
+
+	replayed := &counterGame{}
+	p, err := NewReplayer(bytes.NewReader(buf.Bytes()), replayed, &typedParser{game: replayed})
+	testutils.Equal(t, err, nil)
+	testutils.Equal(t, p.Run(ctx), nil)
+	testutils.Equal(t, replayed.value, int64(56))
+}
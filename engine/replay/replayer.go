@@ -0,0 +1,120 @@
+package replay
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/InsideGallery/game-core/engine"
+	"github.com/InsideGallery/game-core/engine/communications"
+)
+
+// Replayer feed recorded commands back into the game at the same ticks
+type Replayer struct {
+	reader *Reader
+	game   engine.Game
+	parser communications.CommandParser
+	state  engine.Checksummer
+	tick   uint64
+}
+
+// NewReplayer return new replayer, checksums verified if game implements engine.Checksummer
+func NewReplayer(r io.Reader, game engine.Game, parser communications.CommandParser) (*Replayer, error) {
+	reader, err := NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	state, _ := game.(engine.Checksummer)
+
+	return &Replayer{
+		reader: reader,
+		game:   game,
+		parser: parser,
+		state:  state,
+	}, nil
+}
+
+// SetChecksummer set state used for divergence detection
+func (p *Replayer) SetChecksummer(state engine.Checksummer) {
+	p.state = state
+}
+
+// Tick return count of replayed game ticks
+func (p *Replayer) Tick() uint64 {
+	return p.tick
+}
+
+// Step apply next entry, return io.EOF when replay is over
+func (p *Replayer) Step(ctx context.Context) error {
+	e, err := p.reader.Next()
+	if err != nil {
+		return err
+	}
+
+	switch e.Kind {
+	case EntryCommand:
+		if e.Tick < p.tick {
+			return ErrTickInPast
+		}
+
+		p.advance(ctx, e.Tick)
+
+		cmd, err := p.parser.Parse(e.Payload)
+		if err != nil {
+			return err
+		}
+
+		if cmd.GetMsgType() != e.MsgType {
+			return ErrMsgTypeMismatch
+		}
+
+		return cmd.Execute(ctx)
+	case EntryChecksum:
+		if e.Tick < p.tick {
+			return ErrTickInPast
+		}
+
+		p.advance(ctx, e.Tick+1)
+
+		if p.state == nil {
+			return ErrNotChecksummable
+		}
+
+		if actual := p.state.Checksum(); actual != e.Checksum {
+			return &DivergenceError{
+				Tick:     e.Tick,
+				Expected: e.Checksum,
+				Actual:   actual,
+			}
+		}
+	}
+
+	return nil
+}
+
+// Run replay all entries, return *DivergenceError on first checksum mismatch
+func (p *Replayer) Run(ctx context.Context) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		err := p.Step(ctx)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// advance tick game until given tick
+func (p *Replayer) advance(ctx context.Context, tick uint64) {
+	for p.tick < tick {
+		p.game.Tick(ctx)
+		p.tick++
+	}
+}
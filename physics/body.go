@@ -0,0 +1,267 @@
+package physics
+
+import (
+	"github.com/InsideGallery/game-core/geometry/shapes"
+)
+
+// RigidBody describe body with mass, inertia and orientation, forces applied out of center make it rotate
+type RigidBody struct {
+	Material Material
+
+	Position        shapes.Point // center of mass
+	Velocity        shapes.Point
+	Orientation     Quaternion
+	AngularVelocity shapes.Point // radians per time unit around each axis
+	Inertia         Matrix3      // inertia tensor in body coordinates
+
+	shape  shapes.Collide
+	origin shapes.Point // center of shape without rotation
+	force  shapes.Point
+	torque shapes.Point
+}
+
+// NewRigidBody create body from shape placed in world, body with zero mass is static
+func NewRigidBody(shape shapes.Collide, material Material) *RigidBody {
+	return &RigidBody{
+		Material:    material,
+		Position:    shape.Center(),
+		Orientation: IdentityQuaternion(),
+		Inertia:     InertiaTensor(shape, material.Mass),
+		shape:       shape,
+		origin:      shape.Center(),
+	}
+}
+
+// InertiaTensor return inertia tensor of solid sphere for spheres and of solid box by bounds for other shapes
+func InertiaTensor(shape shapes.Collide, mass float64) Matrix3 {
+	if s, ok := shape.Get().(shapes.Sphere); ok {
+		i := 0.4 * mass * s.Radius() * s.Radius() //nolint:mnd
+		return NewDiagonalMatrix(i, i, i)
+	}
+
+	size := shape.Bounds().Sizes()
+	w, h, d := size[0]*size[0], size[1]*size[1], size[2]*size[2] //nolint:mnd
+	k := mass / 12                                               //nolint:mnd
+
+	return NewDiagonalMatrix(k*(h+d), k*(w+d), k*(w+h))
+}
+
+// IsStatic return true if body is not moved by forces and impulses
+func (b *RigidBody) IsStatic() bool {
+	return b.Material.Mass == 0
+}
+
+// InverseMass return inverse mass, zero for static body
+func (b *RigidBody) InverseMass() float64 {
+	if b.IsStatic() {
+		return 0
+	}
+
+	return 1 / b.Material.Mass
+}
+
+// InverseInertia return inverse inertia tensor in world coordinates, zero for static body
+func (b *RigidBody) InverseInertia() Matrix3 {
+	if b.IsStatic() {
+		return Matrix3{}
+	}
+
+	r := b.Orientation.Matrix()
+
+	return r.Multiply(inverseInertia(b.Inertia)).Multiply(r.Transpose())
+}
+
+// Shape return shape of body in world coordinates
+func (b *RigidBody) Shape() shapes.Collide {
+	return bodyShape{shape: b.shape, origin: b.origin, position: b.Position, orientation: b.Orientation}
+}
+
+// VelocityAt return velocity of body point
+func (b *RigidBody) VelocityAt(p shapes.Point) shapes.Point {
+	return b.Velocity.Add(b.AngularVelocity.Cross(p.Subtract(b.Position)))
+}
+
+// Accelerate apply acceleration
+func (b *RigidBody) Accelerate(rate shapes.Point) {
+	b.force = b.force.Add(rate.Scale(b.Material.Mass))
+}
+
+// ApplyForce apply force to center of mass
+func (b *RigidBody) ApplyForce(force shapes.Point) {
+	b.force = b.force.Add(force)
+}
+
+// ApplyForceAt apply force to point of body, it produces torque
+func (b *RigidBody) ApplyForceAt(force, at shapes.Point) {
+	b.force = b.force.Add(force)
+	b.torque = b.torque.Add(at.Subtract(b.Position).Cross(force))
+}
+
+// ApplyTorque apply torque
+func (b *RigidBody) ApplyTorque(torque shapes.Point) {
+	b.torque = b.torque.Add(torque)
+}
+
+// ApplyImpulse immediately change linear and angular velocity by impulse applied to point of body
+func (b *RigidBody) ApplyImpulse(impulse, at shapes.Point) {
+	if b.IsStatic() {
+		return
+	}
+
+	b.Velocity = b.Velocity.Add(impulse.Scale(b.InverseMass()))
+	b.AngularVelocity = b.AngularVelocity.Add(b.InverseInertia().MultiplyPoint(at.Subtract(b.Position).Cross(impulse)))
+}
+
+// Simulate integrate forces and velocities during delta time, velocities are reduced by damping of material
+func (b *RigidBody) Simulate(delta float64) {
+	if b.IsStatic() {
+		b.ResetForces()
+		return
+	}
+
+	r := b.Orientation.Matrix()
+	inertia := r.Multiply(b.Inertia).Multiply(r.Transpose())
+	gyroscopic := b.AngularVelocity.Cross(inertia.MultiplyPoint(b.AngularVelocity))
+
+	b.Velocity = b.Velocity.Add(b.force.Scale(b.InverseMass() * delta))
+	b.AngularVelocity = b.AngularVelocity.Add(b.InverseInertia().MultiplyPoint(b.torque.Subtract(gyroscopic)).Scale(delta))
+	b.Velocity = b.Velocity.Scale(damping(b.Material.LinearDamping, delta))
+	b.AngularVelocity = b.AngularVelocity.Scale(damping(b.Material.AngularDamping, delta))
+	b.Position = b.Position.Add(b.Velocity.Scale(delta))
+	b.Orientation = b.Orientation.Integrate(b.AngularVelocity, delta)
+	b.ResetForces()
+}
+
+// ResetForces reset forces and torque
+func (b *RigidBody) ResetForces() {
+	b.force = shapes.NewPoint(0, 0, 0)
+	b.torque = shapes.NewPoint(0, 0, 0)
+}
+
+// Restrain return body into border and stop its movement out of border
+func (b *RigidBody) Restrain(border shapes.Border, dimensions int) {
+	bounds := b.Shape().Bounds()
+	position := b.Position.Coordinates()
+	velocity := b.Velocity.Coordinates()
+
+	for k := range dimensions {
+		if d := border.Point1().Coordinate(k) - bounds.Point1().Coordinate(k); d > 0 {
+			position[k] += d
+			velocity[k] = max(velocity[k], 0)
+		} else if d := border.Point2().Coordinate(k) - bounds.Point2().Coordinate(k); d < 0 {
+			position[k] += d
+			velocity[k] = min(velocity[k], 0)
+		}
+	}
+
+	b.Position = shapes.CoordinatesToPoint(position)
+	b.Velocity = shapes.CoordinatesToPoint(velocity)
+}
+
+// SetMaterial set material and scale inertia to new mass
+func (b *RigidBody) SetMaterial(material Material) {
+	b.Inertia = InertiaTensor(b.shape, material.Mass)
+	b.Material = material
+}
+
+func (b *RigidBody) material() Material {
+	return b.Material
+}
+
+func (b *RigidBody) inverseMass() float64 {
+	return b.InverseMass()
+}
+
+func (b *RigidBody) inverseInertia() Matrix3 {
+	return b.InverseInertia()
+}
+
+func (b *RigidBody) center() shapes.Point {
+	return b.Position
+}
+
+func (b *RigidBody) velocityAt(p shapes.Point, _ float64) shapes.Point {
+	return b.VelocityAt(p)
+}
+
+func (b *RigidBody) addVelocity(linear, angular shapes.Point, _ float64) {
+	b.Velocity = b.Velocity.Add(linear)
+	b.AngularVelocity = b.AngularVelocity.Add(angular)
+}
+
+func (b *RigidBody) translate(diff shapes.Point) {
+	b.Position = b.Position.Add(diff)
+}
+
+// inverseInertia return inverse of inertia tensor, axes with zero inertia can not rotate
+func inverseInertia(m Matrix3) Matrix3 {
+	if m.Determinant() != 0 {
+		return m.Inverse()
+	}
+
+	var r Matrix3
+
+	for i := range r {
+		if m[i][i] != 0 {
+			r[i][i] = 1 / m[i][i]
+		}
+	}
+
+	return r
+}
+
+// bodyShape shape of body moved and rotated to world coordinates
+type bodyShape struct {
+	shape       shapes.Collide
+	origin      shapes.Point
+	position    shapes.Point
+	orientation Quaternion
+}
+
+// Support return support point in world coordinates
+func (s bodyShape) Support(d shapes.Point) shapes.Point {
+	local := s.shape.Support(s.orientation.Conjugate().Rotate(d)).Subtract(s.origin)
+	return s.orientation.Rotate(local).Add(s.position)
+}
+
+// Point1 return center of body
+func (s bodyShape) Point1() shapes.Point {
+	return s.position
+}
+
+// Center return center of body
+func (s bodyShape) Center() shapes.Point {
+	return s.position
+}
+
+// Coordinates return coordinates of center
+func (s bodyShape) Coordinates() [3]float64 {
+	return s.position.Coordinates()
+}
+
+// Get return Spatial
+func (s bodyShape) Get() shapes.Spatial {
+	return s
+}
+
+// Move return shape moved by diff
+func (s bodyShape) Move(diff shapes.Point) shapes.Spatial {
+	s.position = s.position.Add(diff)
+	return s
+}
+
+// Bounds return rectangle of rotated shape
+func (s bodyShape) Bounds() shapes.Box {
+	var lo, size [3]float64
+
+	for k := range lo {
+		axis := [3]float64{}
+		axis[k] = 1
+		p := shapes.CoordinatesToPoint(axis)
+
+		lo[k] = s.Support(p.Invert()).Coordinate(k)
+		size[k] = s.Support(p).Coordinate(k) - lo[k]
+	}
+
+	return shapes.NewBox(shapes.CoordinatesToPoint(lo), size[:]...)
+}
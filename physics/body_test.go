@@ -0,0 +1,58 @@
+package physics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/InsideGallery/core/testutils"
+	"github.com/InsideGallery/game-core/geometry/shapes"
+)
+
+func TestQuaternion(t *testing.T) {
+	q := NewQuaternion(shapes.NewPoint(0, 0, 1), math.Pi/2)
+	testutils.Equal(t, q.Rotate(shapes.NewPoint(1, 0, 0)).Round(0.001), shapes.NewPoint(0, 1, 0))
+	testutils.Equal(t, q.Matrix().MultiplyPoint(shapes.NewPoint(1, 0, 0)).Round(0.001), shapes.NewPoint(0, 1, 0))
+	testutils.Equal(t, math.Round(q.Yaw()*1000)/1000, 1.571)
+	testutils.Equal(t, q.Multiply(q.Conjugate()).Normalize(), IdentityQuaternion())
+}
+
+func TestRigidBody(t *testing.T) {
+	b := NewRigidBody(shapes.NewBox(shapes.NewPoint(-1, -2), 2, 4), NewMaterial(12))
+	testutils.Equal(t, b.Position, shapes.NewPoint(0, 0))
+	testutils.Equal(t, b.Inertia, NewDiagonalMatrix(16, 4, 20))
+
+	b.ApplyTorque(shapes.NewPoint(0, 0, 20))
+	b.Simulate(1)
+	testutils.Equal(t, b.AngularVelocity, shapes.NewPoint(0, 0, 1))
+	testutils.Equal(t, math.Round(b.Orientation.Yaw()*1000)/1000, 0.927)
+
+	b = NewRigidBody(shapes.NewBox(shapes.NewPoint(-1, -2), 2, 4), NewMaterial(12))
+	b.ApplyImpulse(shapes.NewPoint(0, 12), shapes.NewPoint(1, 0))
+	testutils.Equal(t, b.Velocity, shapes.NewPoint(0, 1))
+	testutils.Equal(t, b.AngularVelocity.Round(0.001), shapes.NewPoint(0, 0, 0.6))
+	testutils.Equal(t, b.VelocityAt(shapes.NewPoint(1, 0)).Round(0.001), shapes.NewPoint(0, 1.6))
+
+	b.Orientation = NewQuaternion(shapes.NewPoint(0, 0, 1), math.Pi/2)
+	bounds := b.Shape().Bounds()
+	testutils.Equal(t, bounds.Point1().Round(0.001), shapes.NewPoint(-2, -1))
+	testutils.Equal(t, bounds.VectorSizes().Round(0.001), shapes.NewPoint(4, 2))
+
+	static := NewRigidBody(shapes.NewSphere(shapes.NewPoint(5, 5), 1), NewMaterial(0))
+	static.ApplyImpulse(shapes.NewPoint(1, 1), shapes.NewPoint(5, 6))
+	static.ApplyForce(shapes.NewPoint(1, 1))
+	static.Simulate(1)
+	testutils.Equal(t, static.Position, shapes.NewPoint(5, 5))
+	testutils.Equal(t, static.Velocity, shapes.Point{})
+}
+
+func TestWorldBodies(t *testing.T) {
+	w := NewWorld(shapes.NewBorder(shapes.NewBox(shapes.NewPoint(-100, -100), 200, 200)), shapes.NewPoint(0, 10), 4)
+	box := NewRigidBody(shapes.NewBox(shapes.NewPoint(-1, -1), 2, 2), NewMaterial(1))
+	box.ApplyForceAt(shapes.NewPoint(0, 1), shapes.NewPoint(1, 0))
+	w.AddBodies(box)
+	w.Simulate(100, 2)
+
+	testutils.Equal(t, box.Velocity.Coordinate(1), 0.0)
+	testutils.Equal(t, math.Round(box.Shape().Bounds().Point2().Coordinate(1)), 100.0)
+	testutils.Equal(t, box.AngularVelocity.Coordinate(2) > 0, true)
+}
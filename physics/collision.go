@@ -0,0 +1,200 @@
+package physics
+
+import (
+	"github.com/InsideGallery/game-core/geometry/gjkepa2d"
+	"github.com/InsideGallery/game-core/geometry/gjkepa3d"
+	"github.com/InsideGallery/game-core/geometry/shapes"
+	"github.com/InsideGallery/game-core/rtree"
+)
+
+const (
+	// DefaultCollisionIterations count of collision solver iterations per step
+	DefaultCollisionIterations = 8
+
+	correctionPercent = 0.8  // share of penetration fixed per step
+	correctionSlop    = 0.01 // penetration allowed without correction
+	supportTilt       = 0.05 // tilt of normal used to find corners of touching faces
+	slideTolerance    = 1e-6 // share of static friction limit when contact starts to slide
+)
+
+// Collider object which takes part in collisions: rigid body or particle with shape
+type Collider interface {
+	Shape() shapes.Collide
+	material() Material
+	inverseMass() float64
+	inverseInertia() Matrix3
+	center() shapes.Point
+	velocityAt(p shapes.Point, delta float64) shapes.Point
+	addVelocity(linear, angular shapes.Point, delta float64)
+	translate(diff shapes.Point)
+}
+
+// Contact describe collision of two objects
+type Contact struct {
+	A, B   Collider
+	Normal shapes.Point   // unit vector from A to B
+	Depth  float64        // penetration depth
+	Points []shapes.Point // contact points
+}
+
+// colliderProxy collider in broadphase tree
+type colliderProxy struct {
+	shapes.Collide
+	index int
+	group int // colliders of the same group do not collide, negative group collides with all
+}
+
+// Bounds return bounds of shape
+func (p colliderProxy) Bounds() shapes.Box {
+	return p.Collide.Bounds()
+}
+
+// Contacts return collisions of bodies and shaped particles of different composites,
+// pairs are found by rtree and checked by GJK and EPA
+func (w *World) Contacts(dimensions int) []Contact {
+	colliders, proxies := w.colliders()
+	tree := rtree.NewRTree(rtree.DefaultMinRTreeOption, rtree.DefaultMaxRTreeOption)
+
+	for _, p := range proxies {
+		tree.Insert(p)
+	}
+
+	var contacts []Contact
+
+	for _, p := range proxies {
+		candidates := tree.SearchIntersect(p.Bounds(), func(s shapes.Spatial) bool {
+			o := s.(colliderProxy)
+			return o.index <= p.index || (p.group >= 0 && o.group == p.group)
+		})
+
+		for _, s := range candidates {
+			o := s.(colliderProxy)
+
+			a, b := colliders[p.index], colliders[o.index]
+			if a.inverseMass() == 0 && b.inverseMass() == 0 {
+				continue
+			}
+
+			if c, ok := collide(p.Collide, o.Collide, dimensions); ok {
+				c.A, c.B = a, b
+				contacts = append(contacts, c)
+			}
+		}
+	}
+
+	return contacts
+}
+
+// colliders return bodies and shaped particles with their proxies
+func (w *World) colliders() ([]Collider, []colliderProxy) {
+	var (
+		colliders []Collider
+		proxies   []colliderProxy
+	)
+
+	add := func(c Collider, group int) {
+		proxies = append(proxies, colliderProxy{Collide: c.Shape(), index: len(colliders), group: group})
+		colliders = append(colliders, c)
+	}
+
+	for i, c := range w.Composites {
+		for _, p := range c.Particles {
+			if p.shape != nil {
+				add(p, i)
+			}
+		}
+	}
+
+	for _, b := range w.Bodies {
+		add(b, -1)
+	}
+
+	return colliders, proxies
+}
+
+// collide return contact of two shapes without colliders
+func collide(a, b shapes.Collide, dimensions int) (Contact, bool) {
+	var (
+		collision bool
+		mtv       shapes.Point
+	)
+
+	if dimensions == 2 { //nolint:mnd
+		collision, mtv = gjkepa2d.NewGJKEPA().GJK(a, b, true)
+	} else {
+		collision, mtv = gjkepa3d.NewGJKEPA().GJK(a, b, true)
+	}
+
+	// mtv moves a out of b
+	if !collision || mtv.Normal() == 0 {
+		return Contact{}, false
+	}
+
+	normal := mtv.Invert().Normalize()
+
+	return Contact{Normal: normal, Depth: mtv.Normal(), Points: contactPoints(a, b, normal)}, true
+}
+
+// contactPoints return corners of touching faces found by supports in tilted normal directions,
+// center of intersection of bounds is used if there are no such corners
+func contactPoints(a, b shapes.Collide, normal shapes.Point) []shapes.Point {
+	overlap, _ := a.Bounds().Intersect(b.Bounds())
+	surfaceA, surfaceB := a.Support(normal), b.Support(normal.Invert())
+
+	var result []shapes.Point
+
+	add := func(p shapes.Point, depth float64) {
+		if depth < -correctionSlop || !nearBox(overlap, p) {
+			return
+		}
+
+		for _, o := range result {
+			if o.Distance(p) < correctionSlop {
+				return
+			}
+		}
+
+		result = append(result, p)
+	}
+
+	for _, d := range tiltedNormals(normal) {
+		pa, pb := a.Support(d), b.Support(d.Invert())
+		add(pa, pa.Subtract(surfaceB).Dot(normal))
+		add(pb, surfaceA.Subtract(pb).Dot(normal))
+	}
+
+	if len(result) == 0 {
+		return []shapes.Point{overlap.Center()}
+	}
+
+	return result
+}
+
+// tiltedNormals return normal and normal slightly tilted to four sides
+func tiltedNormals(normal shapes.Point) []shapes.Point {
+	t1, t2 := tangents(normal)
+	t1, t2 = t1.Scale(supportTilt), t2.Scale(supportTilt)
+
+	return []shapes.Point{normal, normal.Add(t1), normal.Subtract(t1), normal.Add(t2), normal.Subtract(t2)}
+}
+
+// tangents return two unit vectors perpendicular to normal and each other, the first one lies in xy plane for 2D normal
+func tangents(normal shapes.Point) (shapes.Point, shapes.Point) {
+	axis := [3]float64{}
+	axis[normal.Abs().GetMinAxis()] = 1
+
+	t1 := normal.Cross(shapes.CoordinatesToPoint(axis)).Normalize()
+
+	return t1, normal.Cross(t1).Normalize()
+}
+
+// nearBox return true if point is inside of box extended by correction slop
+func nearBox(b shapes.Box, p shapes.Point) bool {
+	for k := range 3 {
+		if p.Coordinate(k) < b.Point1().Coordinate(k)-correctionSlop || p.Coordinate(k) > b.Point2().Coordinate(k)+correctionSlop {
+			return false
+		}
+	}
+
+	return true
+}
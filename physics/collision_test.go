@@ -0,0 +1,110 @@
+package physics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/InsideGallery/core/testutils"
+	"github.com/InsideGallery/game-core/geometry/shapes"
+)
+
+func newTestWorld(gravity float64) *World {
+	return NewWorld(shapes.NewBorder(shapes.NewBox(shapes.NewPoint(-100, -100), 200, 200)), shapes.NewPoint(0, gravity), 4)
+}
+
+func TestContacts(t *testing.T) {
+	w := newTestWorld(0)
+	a := NewRigidBody(shapes.NewBox(shapes.NewPoint(0, 0), 2, 2), NewMaterial(1))
+	b := NewRigidBody(shapes.NewBox(shapes.NewPoint(1.5, 0), 2, 2), NewMaterial(1))
+	c := NewRigidBody(shapes.NewBox(shapes.NewPoint(10, 0), 2, 2), NewMaterial(1))
+	w.AddBodies(a, b, c)
+
+	contacts := w.Contacts(2)
+	testutils.Equal(t, len(contacts), 1)
+	testutils.Equal(t, contacts[0].A, Collider(a))
+	testutils.Equal(t, contacts[0].B, Collider(b))
+	testutils.Equal(t, contacts[0].Normal.Round(0.001), shapes.NewPoint(1, 0))
+	testutils.Equal(t, math.Round(contacts[0].Depth*1000)/1000, 0.5)
+	testutils.Equal(t, contacts[0].Points, []shapes.Point{shapes.NewPoint(2, 0), shapes.NewPoint(1.5, 0), shapes.NewPoint(1.5, 2), shapes.NewPoint(2, 2)})
+
+	// static bodies and particles of the same composite do not collide
+	a.SetMaterial(NewMaterial(0))
+	b.SetMaterial(NewMaterial(0))
+
+	rope := NewComposite()
+	for _, x := range []float64{50, 51} {
+		p := NewParticle(shapes.NewPoint(x, 0), NewMaterial(1))
+		p.SetShape(shapes.NewSphere(shapes.NewPoint(0, 0), 1))
+		rope.AddParticle(p)
+	}
+
+	w.AddComposites(rope)
+	testutils.Equal(t, len(w.Contacts(2)), 0)
+}
+
+func TestBounce(t *testing.T) {
+	w := newTestWorld(0)
+	ball := NewRigidBody(shapes.NewSphere(shapes.NewPoint(0, 0), 1), Material{Mass: 1, Restitution: 1})
+	ball.Velocity = shapes.NewPoint(0, 8)
+	floor := NewRigidBody(shapes.NewBox(shapes.NewPoint(-10, 5), 20, 2), Material{Restitution: 1})
+	w.AddBodies(ball, floor)
+	w.Simulate(20, 2)
+
+	testutils.Equal(t, ball.Velocity.Round(0.001), shapes.NewPoint(0, -8))
+	testutils.Equal(t, floor.Position, shapes.NewPoint(0, 6))
+}
+
+func TestParticleCollision(t *testing.T) {
+	w := newTestWorld(0)
+	left, right := NewComposite(), NewComposite()
+
+	for _, c := range []struct {
+		composite *Composite
+		x, speed  float64
+	}{{left, -5, 1}, {right, 5, -1}} {
+		p := NewParticle(shapes.NewPoint(c.x, 0), Material{Mass: 1, Restitution: 1})
+		p.Previous = shapes.NewPoint(c.x-c.speed, 0)
+		p.SetShape(shapes.NewSphere(shapes.NewPoint(0, 0), 1))
+		c.composite.AddParticle(p)
+	}
+
+	w.AddComposites(left, right)
+	w.Simulate(10, 2)
+
+	l, r := left.Particles[0], right.Particles[0]
+	testutils.Equal(t, l.Position.Subtract(l.Previous).Round(0.01), shapes.NewPoint(-1, 0))
+	testutils.Equal(t, r.Position.Subtract(r.Previous).Round(0.01), shapes.NewPoint(1, 0))
+	testutils.Equal(t, r.Position.Coordinate(0)-l.Position.Coordinate(0) >= 2-correctionSlop, true)
+}
+
+func TestStackAndFriction(t *testing.T) {
+	newWorld := func(friction float64) (*World, *RigidBody) {
+		w := NewWorld(shapes.NewBorder(shapes.NewBox(shapes.NewPoint(-100, -100), 200, 200)), shapes.NewPoint(0, 10), 60)
+		floor := NewRigidBody(shapes.NewBox(shapes.NewPoint(-100, 50), 200, 10), Material{KineticFriction: friction})
+		lower := NewRigidBody(shapes.NewBox(shapes.NewPoint(-1, 48), 2, 2), Material{Mass: 1, KineticFriction: friction})
+		w.AddBodies(floor, lower)
+
+		return w, lower
+	}
+
+	w, lower := newWorld(0.5)
+	upper := NewRigidBody(shapes.NewBox(shapes.NewPoint(-1, 46), 2, 2), Material{Mass: 1, KineticFriction: 0.5})
+	w.AddBodies(upper)
+	w.Simulate(300, 2)
+
+	testutils.Equal(t, math.Abs(lower.Shape().Bounds().Point2().Coordinate(1)-50) < 0.05, true)
+	testutils.Equal(t, math.Abs(upper.Shape().Bounds().Point2().Coordinate(1)-48) < 0.05, true)
+	testutils.Equal(t, upper.Velocity.Round(0.1), shapes.NewPoint(0, 0))
+	testutils.Equal(t, math.Round(upper.Orientation.Yaw()*100)/100, 0.0)
+
+	for _, c := range []struct {
+		friction float64
+		moving   bool
+	}{{0, true}, {1, false}} {
+		w, box := newWorld(c.friction)
+		box.Velocity = shapes.NewPoint(2, 0)
+		w.Simulate(60, 2)
+
+		testutils.Equal(t, math.Abs(box.Velocity.Coordinate(0)) > 1, c.moving)
+	}
+}
@@ -33,3 +33,19 @@ func TestComposite(t *testing.T) {
 	testutils.Equal(t, rope.AddConstraints(8, 9, 1.0), nil)
 	w.Simulate(4, 2)
 }
+
+func TestParticleRestrain(t *testing.T) {
+	border := shapes.NewBorder(shapes.NewBox(shapes.NewPoint(-10, -10), 20, 20))
+
+	p := NewParticle(shapes.NewPoint(5, 5), NewMaterial(1))
+	p.Restrain(border, 2)
+	testutils.Equal(t, p.Position, shapes.NewPoint(5, 5))
+
+	p = NewParticle(shapes.NewPoint(15, -12), NewMaterial(1))
+	p.Restrain(border, 2)
+	testutils.Equal(t, p.Position, shapes.NewPoint(10, -10))
+
+	p = NewParticle(shapes.NewPoint(-11, 3, 50), NewMaterial(1))
+	p.Restrain(border, 2)
+	testutils.Equal(t, p.Position, shapes.NewPoint(-10, 3, 50))
+}
@@ -27,11 +27,22 @@ func NewConstraint(p1, p2 *Particle, springConstant, distanceConstraint float64)
 	}
 }
 
-// Relax add relax forces
+// Relax add relax forces, relative movement along constraint is reduced by Damp
+// or by combined linear damping of particles if Damp is zero
 func (c *Constraint) Relax() {
 	D := c.Particle2.Position.Subtract(c.Particle1.Position)
 	F := D.Normalize().Scale(0.5 * c.Stiff * (D.Normal() - c.Target))
 
+	damp := c.Damp
+	if damp == 0 {
+		damp = CombineMaterials(c.Particle1.Material, c.Particle2.Material).LinearDamping
+	}
+
+	if damp != 0 {
+		movement := c.Particle2.Position.Subtract(c.Particle2.Previous).Subtract(c.Particle1.Position.Subtract(c.Particle1.Previous))
+		F = F.Add(D.Normalize().Scale(0.5 * min(damp, 1) * movement.Dot(D.Normalize()))) //nolint:mnd
+	}
+
 	if c.Particle1.Material.Mass != 0 && c.Particle2.Material.Mass == 0 { //nolint:gocritic
 		c.Particle1.ApplyImpulse(F.Scale(2)) // nolint:mnd
 	} else if c.Particle1.Material.Mass == 0 && c.Particle2.Material.Mass != 0 {
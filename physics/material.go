@@ -1,8 +1,46 @@
 package physics
 
+import (
+	"math"
+
+	"github.com/InsideGallery/game-core/geometry/shapes"
+)
+
+// CombineRule describe how property of two materials is combined for pair of objects
+type CombineRule int
+
+// Combine rules, when materials have different rules the last one in this list wins
+const (
+	CombineAverage CombineRule = iota
+	CombineMin
+	CombineMultiply
+	CombineMax
+)
+
+// Combine return combined value of two properties
+func (r CombineRule) Combine(a, b float64) float64 {
+	switch r {
+	case CombineMin:
+		return min(a, b)
+	case CombineMultiply:
+		return a * b
+	case CombineMax:
+		return max(a, b)
+	default:
+		return (a + b) / 2 //nolint:mnd
+	}
+}
+
 // Material describe material
 type Material struct {
-	Mass float64
+	Mass            float64
+	Density         float64 // mass per volume unit, used by ForShape
+	Restitution     float64 // bounciness from 0 (no bounce) to 1 (elastic collision)
+	StaticFriction  float64 // friction coefficient at rest, kinetic friction is used if it is lower
+	KineticFriction float64 // friction coefficient of sliding objects
+	LinearDamping   float64 // share of linear velocity lost per time unit
+	AngularDamping  float64 // share of angular velocity lost per time unit
+	Combine         CombineRule
 }
 
 // NewMaterial return new material
@@ -11,3 +49,63 @@ func NewMaterial(mass float64) Material {
 		Mass: mass,
 	}
 }
+
+// ForShape return material with mass derived from density and volume of shape, material without density is not changed
+func (m Material) ForShape(shape shapes.Collide, dimensions int) Material {
+	if m.Density > 0 {
+		m.Mass = m.Density * Volume(shape, dimensions)
+	}
+
+	return m
+}
+
+// CombineMaterials return material of pair of objects, rule with higher priority is used to combine properties
+func CombineMaterials(a, b Material) Material {
+	rule := max(a.Combine, b.Combine)
+
+	return Material{
+		Restitution:     rule.Combine(a.Restitution, b.Restitution),
+		StaticFriction:  rule.Combine(a.staticFriction(), b.staticFriction()),
+		KineticFriction: rule.Combine(a.KineticFriction, b.KineticFriction),
+		LinearDamping:   rule.Combine(a.LinearDamping, b.LinearDamping),
+		AngularDamping:  rule.Combine(a.AngularDamping, b.AngularDamping),
+		Combine:         rule,
+	}
+}
+
+// staticFriction return static friction, it is never lower than kinetic one
+func (m Material) staticFriction() float64 {
+	return max(m.StaticFriction, m.KineticFriction)
+}
+
+// damping return factor of velocity left after delta time
+func damping(rate, delta float64) float64 {
+	return 1 / (1 + rate*delta)
+}
+
+// Volume return volume of shape, area for 2 dimensions, volume of bounds is used for unknown shapes
+func Volume(shape shapes.Collide, dimensions int) float64 {
+	switch s := shape.Get().(type) {
+	case shapes.Sphere:
+		if dimensions == 2 { //nolint:mnd
+			return math.Pi * s.Radius() * s.Radius()
+		}
+
+		return 4.0 / 3.0 * math.Pi * s.Radius() * s.Radius() * s.Radius() //nolint:mnd
+	case shapes.Box:
+		return boxVolume(s, dimensions)
+	default:
+		return boxVolume(shape.Bounds(), dimensions)
+	}
+}
+
+// boxVolume return volume of box in given dimensions
+func boxVolume(b shapes.Box, dimensions int) float64 {
+	if dimensions >= 3 { //nolint:mnd
+		return b.Volume()
+	}
+
+	size := b.Sizes()
+
+	return size[0] * size[1]
+}
@@ -0,0 +1,72 @@
+package physics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/InsideGallery/core/testutils"
+	"github.com/InsideGallery/game-core/geometry/shapes"
+)
+
+func TestCombineMaterials(t *testing.T) {
+	a := Material{Restitution: 0.2, KineticFriction: 0.4, LinearDamping: 1}
+	b := Material{Restitution: 0.6, StaticFriction: 0.8, KineticFriction: 0.2}
+
+	testutils.Equal(t, CombineMaterials(a, b), Material{Restitution: 0.4, StaticFriction: 0.6000000000000001, KineticFriction: 0.30000000000000004, LinearDamping: 0.5})
+
+	b.Combine = CombineMin
+	testutils.Equal(t, CombineMaterials(a, b).Restitution, 0.2)
+	testutils.Equal(t, CombineMaterials(a, b).StaticFriction, 0.4)
+
+	a.Combine = CombineMax
+	testutils.Equal(t, CombineMaterials(a, b).Restitution, 0.6)
+	testutils.Equal(t, CombineMaterials(a, b).Combine, CombineMax)
+	testutils.Equal(t, CombineMultiply.Combine(0.5, 0.4), 0.2)
+}
+
+func TestMaterialForShape(t *testing.T) {
+	m := Material{Density: 2}
+	box := shapes.NewBox(shapes.NewPoint(0, 0), 2, 3, 4)
+
+	testutils.Equal(t, m.ForShape(box, 3).Mass, 48.0)
+	testutils.Equal(t, m.ForShape(box, 2).Mass, 12.0)
+	testutils.Equal(t, math.Round(m.ForShape(shapes.NewSphere(shapes.NewPoint(0, 0), 1), 2).Mass*1000)/1000, 6.283)
+	testutils.Equal(t, math.Round(m.ForShape(shapes.NewSphere(shapes.NewPoint(0, 0), 1), 3).Mass*1000)/1000, 8.378)
+	testutils.Equal(t, NewMaterial(5).ForShape(box, 3).Mass, 5.0)
+}
+
+func TestDamping(t *testing.T) {
+	b := NewRigidBody(shapes.NewBox(shapes.NewPoint(-1, -1), 2, 2), Material{Mass: 1, LinearDamping: 1, AngularDamping: 3})
+	b.Velocity = shapes.NewPoint(4, 0)
+	b.AngularVelocity = shapes.NewPoint(0, 0, 4)
+	b.Simulate(1)
+
+	testutils.Equal(t, b.Velocity, shapes.NewPoint(2, 0))
+	testutils.Equal(t, b.AngularVelocity, shapes.NewPoint(0, 0, 1))
+
+	p := NewParticle(shapes.NewPoint(4, 0), Material{Mass: 1, LinearDamping: 1})
+	p.Previous = shapes.NewPoint(0, 0)
+	p.Simulate(1)
+
+	testutils.Equal(t, p.Position, shapes.NewPoint(6, 0))
+}
+
+func TestStaticFriction(t *testing.T) {
+	for _, c := range []struct {
+		force  float64
+		moving bool
+	}{{4, false}, {8, true}} {
+		w := NewWorld(shapes.NewBorder(shapes.NewBox(shapes.NewPoint(-100, -100), 200, 200)), shapes.NewPoint(0, 10), 60)
+		floor := NewRigidBody(shapes.NewBox(shapes.NewPoint(-100, 50), 200, 10), Material{StaticFriction: 0.5, KineticFriction: 0.1})
+		box := NewRigidBody(shapes.NewBox(shapes.NewPoint(-1, 48), 2, 2), Material{Mass: 1, StaticFriction: 0.5, KineticFriction: 0.1})
+		w.AddBodies(floor, box)
+		w.Simulate(30, 2)
+
+		for range 60 {
+			box.ApplyForce(shapes.NewPoint(c.force, 0))
+			w.Simulate(1, 2)
+		}
+
+		testutils.Equal(t, box.Position.Coordinate(0) > 1, c.moving)
+	}
+}
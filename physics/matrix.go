@@ -0,0 +1,83 @@
+package physics
+
+import "github.com/InsideGallery/game-core/geometry/shapes"
+
+// Matrix3 describe 3x3 matrix, rows go first
+type Matrix3 [3][3]float64
+
+// NewDiagonalMatrix return matrix with given diagonal
+func NewDiagonalMatrix(x, y, z float64) Matrix3 {
+	return Matrix3{{x, 0, 0}, {0, y, 0}, {0, 0, z}}
+}
+
+// Multiply return product of matrices
+func (m Matrix3) Multiply(m2 Matrix3) Matrix3 {
+	var r Matrix3
+
+	for i := range r {
+		for j := range r[i] {
+			for k := range m2 {
+				r[i][j] += m[i][k] * m2[k][j]
+			}
+		}
+	}
+
+	return r
+}
+
+// MultiplyPoint return product of matrix and vector
+func (m Matrix3) MultiplyPoint(p shapes.Point) shapes.Point {
+	c := p.Coordinates()
+
+	return shapes.NewPoint(
+		m[0][0]*c[0]+m[0][1]*c[1]+m[0][2]*c[2],
+		m[1][0]*c[0]+m[1][1]*c[1]+m[1][2]*c[2],
+		m[2][0]*c[0]+m[2][1]*c[1]+m[2][2]*c[2],
+	)
+}
+
+// Transpose return transposed matrix
+func (m Matrix3) Transpose() Matrix3 {
+	var r Matrix3
+
+	for i := range r {
+		for j := range r[i] {
+			r[i][j] = m[j][i]
+		}
+	}
+
+	return r
+}
+
+// Determinant return determinant of matrix
+func (m Matrix3) Determinant() float64 {
+	return m[0][0]*(m[1][1]*m[2][2]-m[1][2]*m[2][1]) -
+		m[0][1]*(m[1][0]*m[2][2]-m[1][2]*m[2][0]) +
+		m[0][2]*(m[1][0]*m[2][1]-m[1][1]*m[2][0])
+}
+
+// Inverse return inverse matrix, zero matrix if matrix is singular
+func (m Matrix3) Inverse() Matrix3 {
+	d := m.Determinant()
+	if d == 0 {
+		return Matrix3{}
+	}
+
+	return Matrix3{
+		{
+			(m[1][1]*m[2][2] - m[1][2]*m[2][1]) / d,
+			(m[0][2]*m[2][1] - m[0][1]*m[2][2]) / d,
+			(m[0][1]*m[1][2] - m[0][2]*m[1][1]) / d,
+		},
+		{
+			(m[1][2]*m[2][0] - m[1][0]*m[2][2]) / d,
+			(m[0][0]*m[2][2] - m[0][2]*m[2][0]) / d,
+			(m[0][2]*m[1][0] - m[0][0]*m[1][2]) / d,
+		},
+		{
+			(m[1][0]*m[2][1] - m[1][1]*m[2][0]) / d,
+			(m[0][1]*m[2][0] - m[0][0]*m[2][1]) / d,
+			(m[0][0]*m[1][1] - m[0][1]*m[1][0]) / d,
+		},
+	}
+}
@@ -12,6 +12,9 @@ type Particle struct {
 	Previous     shapes.Point
 	Velocity     shapes.Point
 	Acceleration shapes.Point
+
+	shape  shapes.Collide
+	origin shapes.Point
 }
 
 // NewParticle create new particle
@@ -29,7 +32,7 @@ func (p *Particle) Simulate(worldDelta float64) {
 		return
 	}
 
-	p.Velocity = p.Position.Scale(2).Subtract(p.Previous) // nolint:mnd
+	p.Velocity = p.Position.Add(p.Position.Subtract(p.Previous).Scale(damping(p.Material.LinearDamping, worldDelta)))
 	p.Previous = p.Position
 	p.Position = p.Velocity.Add(p.Acceleration.Scale(worldDelta * worldDelta)) //nolint:mnd
 	p.Velocity = p.Position.Subtract(p.Previous)
@@ -62,13 +65,66 @@ func (p *Particle) ResetForces() {
 	p.Acceleration = shapes.NewPoint(0, 0, 0)
 }
 
-// Restrain return particle into border on collision
+// Restrain return particle into border, position out of border is clamped to the nearest border point
 func (p *Particle) Restrain(border shapes.Border, dimensions int) {
-	_, points := border.Collision(p.Position, dimensions)
-	p.Position = shapes.NewPoint(points...)
+	position := p.Position.Coordinates()
+	for k := range dimensions {
+		position[k] = min(max(position[k], border.Point1().Coordinate(k)), border.Point2().Coordinate(k))
+	}
+
+	p.Position = shapes.CoordinatesToPoint(position)
 }
 
 // SetMaterial set material
 func (p *Particle) SetMaterial(material Material) {
 	p.Material = material
 }
+
+// SetShape attach collision shape, its center is placed at particle position
+func (p *Particle) SetShape(shape shapes.Collide) {
+	p.shape = shape
+	p.origin = shape.Center()
+}
+
+// Shape return collision shape at particle position, nil if particle has no shape
+func (p *Particle) Shape() shapes.Collide {
+	if p.shape == nil {
+		return nil
+	}
+
+	return bodyShape{shape: p.shape, origin: p.origin, position: p.Position, orientation: IdentityQuaternion()}
+}
+
+func (p *Particle) material() Material {
+	return p.Material
+}
+
+func (p *Particle) inverseMass() float64 {
+	if p.Material.Mass == 0 {
+		return 0
+	}
+
+	return 1 / p.Material.Mass
+}
+
+func (p *Particle) inverseInertia() Matrix3 {
+	return Matrix3{}
+}
+
+func (p *Particle) center() shapes.Point {
+	return p.Position
+}
+
+// velocityAt return velocity per time unit, verlet particle keeps velocity as difference of positions
+func (p *Particle) velocityAt(_ shapes.Point, delta float64) shapes.Point {
+	return p.Position.Subtract(p.Previous).Scale(1 / delta)
+}
+
+func (p *Particle) addVelocity(linear, _ shapes.Point, delta float64) {
+	p.Previous = p.Previous.Subtract(linear.Scale(delta))
+}
+
+func (p *Particle) translate(diff shapes.Point) {
+	p.Position = p.Position.Add(diff)
+	p.Previous = p.Previous.Add(diff)
+}
@@ -0,0 +1,79 @@
+package physics
+
+import (
+	"math"
+
+	"github.com/InsideGallery/game-core/geometry/shapes"
+)
+
+// Quaternion describe orientation in space
+type Quaternion struct {
+	W, X, Y, Z float64
+}
+
+// IdentityQuaternion return quaternion without rotation
+func IdentityQuaternion() Quaternion {
+	return Quaternion{W: 1}
+}
+
+// NewQuaternion return rotation by angle in radian around axis
+func NewQuaternion(axis shapes.Point, angle float64) Quaternion {
+	a := axis.Normalize()
+	sin, cos := math.Sincos(angle / 2) //nolint:mnd
+
+	return Quaternion{W: cos, X: a.Coordinate(0) * sin, Y: a.Coordinate(1) * sin, Z: a.Coordinate(2) * sin} //nolint:mnd
+}
+
+// Multiply return rotation q2 followed by q
+func (q Quaternion) Multiply(q2 Quaternion) Quaternion {
+	return Quaternion{
+		W: q.W*q2.W - q.X*q2.X - q.Y*q2.Y - q.Z*q2.Z,
+		X: q.W*q2.X + q.X*q2.W + q.Y*q2.Z - q.Z*q2.Y,
+		Y: q.W*q2.Y - q.X*q2.Z + q.Y*q2.W + q.Z*q2.X,
+		Z: q.W*q2.Z + q.X*q2.Y - q.Y*q2.X + q.Z*q2.W,
+	}
+}
+
+// Conjugate return inverse rotation of unit quaternion
+func (q Quaternion) Conjugate() Quaternion {
+	return Quaternion{W: q.W, X: -q.X, Y: -q.Y, Z: -q.Z}
+}
+
+// Normalize return unit quaternion, identity for zero quaternion
+func (q Quaternion) Normalize() Quaternion {
+	n := math.Sqrt(q.W*q.W + q.X*q.X + q.Y*q.Y + q.Z*q.Z)
+	if n == 0 {
+		return IdentityQuaternion()
+	}
+
+	return Quaternion{W: q.W / n, X: q.X / n, Y: q.Y / n, Z: q.Z / n}
+}
+
+// Rotate return rotated point
+func (q Quaternion) Rotate(p shapes.Point) shapes.Point {
+	r := q.Multiply(Quaternion{X: p.Coordinate(0), Y: p.Coordinate(1), Z: p.Coordinate(2)}).Multiply(q.Conjugate()) //nolint:mnd
+	return shapes.NewPoint(r.X, r.Y, r.Z)
+}
+
+// Integrate return orientation after rotation with angular velocity during delta time
+func (q Quaternion) Integrate(angularVelocity shapes.Point, delta float64) Quaternion {
+	w := Quaternion{X: angularVelocity.Coordinate(0), Y: angularVelocity.Coordinate(1), Z: angularVelocity.Coordinate(2)} //nolint:mnd
+	d := w.Multiply(q)
+	h := delta / 2 //nolint:mnd
+
+	return Quaternion{W: q.W + d.W*h, X: q.X + d.X*h, Y: q.Y + d.Y*h, Z: q.Z + d.Z*h}.Normalize()
+}
+
+// Yaw return rotation angle around z axis in radian, it is angle of body in 2D world
+func (q Quaternion) Yaw() float64 {
+	return math.Atan2(2*(q.W*q.Z+q.X*q.Y), 1-2*(q.Y*q.Y+q.Z*q.Z)) //nolint:mnd
+}
+
+// Matrix return rotation matrix
+func (q Quaternion) Matrix() Matrix3 {
+	return Matrix3{
+		{1 - 2*(q.Y*q.Y+q.Z*q.Z), 2 * (q.X*q.Y - q.W*q.Z), 2 * (q.X*q.Z + q.W*q.Y)}, //nolint:mnd
+		{2 * (q.X*q.Y + q.W*q.Z), 1 - 2*(q.X*q.X+q.Z*q.Z), 2 * (q.Y*q.Z - q.W*q.X)}, //nolint:mnd
+		{2 * (q.X*q.Z - q.W*q.Y), 2 * (q.Y*q.Z + q.W*q.X), 1 - 2*(q.X*q.X+q.Y*q.Y)}, //nolint:mnd
+	}
+}
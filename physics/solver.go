@@ -0,0 +1,155 @@
+package physics
+
+import "github.com/InsideGallery/game-core/geometry/shapes"
+
+// contactSolver solve contact by sequential impulses accumulated in contact points
+type contactSolver struct {
+	Contact
+	material Material // combined material of pair
+	tangents [2]shapes.Point
+	points   []pointSolver
+}
+
+// pointSolver state of contact point
+type pointSolver struct {
+	ra, rb   shapes.Point // contact point relative to centers of A and B
+	mass     [3]float64   // effective mass along normal and tangents
+	impulse  [3]float64   // accumulated impulses along normal and tangents
+	velocity float64      // target separation velocity
+}
+
+// newContactSolver prepare contact for solving
+func newContactSolver(c Contact, delta float64) *contactSolver {
+	s := &contactSolver{Contact: c, material: CombineMaterials(c.A.material(), c.B.material())}
+	s.tangents[0], s.tangents[1] = tangents(c.Normal)
+
+	for _, p := range c.Points {
+		ps := pointSolver{ra: p.Subtract(c.A.center()), rb: p.Subtract(c.B.center())}
+
+		for i, d := range []shapes.Point{c.Normal, s.tangents[0], s.tangents[1]} {
+			if k := s.effectiveMass(d, ps.ra, ps.rb); k > 0 {
+				ps.mass[i] = 1 / k
+			}
+		}
+
+		if vn := s.relativeVelocity(ps, delta).Dot(c.Normal); vn < 0 {
+			ps.velocity = -s.material.Restitution * vn
+		}
+
+		s.points = append(s.points, ps)
+	}
+
+	return s
+}
+
+// solve apply normal impulses keeping objects apart and friction impulses limited by static friction
+func (s *contactSolver) solve(delta float64) {
+	for i := range s.points {
+		p := &s.points[i]
+
+		vn := s.relativeVelocity(*p, delta).Dot(s.Normal)
+		old := p.impulse[0]
+		p.impulse[0] = max(old+(p.velocity-vn)*p.mass[0], 0)
+		s.applyImpulse(s.Normal.Scale(p.impulse[0]-old), *p, delta)
+
+		v := s.relativeVelocity(*p, delta)
+		oldFriction := s.tangents[0].Scale(p.impulse[1]).Add(s.tangents[1].Scale(p.impulse[2]))
+		friction := oldFriction
+
+		for j, t := range s.tangents {
+			friction = friction.Subtract(t.Scale(v.Dot(t) * p.mass[j+1]))
+		}
+
+		if limit := s.material.StaticFriction * p.impulse[0]; friction.Normal() > limit {
+			friction = friction.Normalize().Scale(limit)
+		}
+
+		s.setFriction(p, friction, delta)
+	}
+}
+
+// slide replace static friction by kinetic one if static friction in all points is not enough to hold objects
+func (s *contactSolver) slide(delta float64) {
+	var friction, normal float64
+
+	for _, p := range s.points {
+		friction += s.tangents[0].Scale(p.impulse[1]).Add(s.tangents[1].Scale(p.impulse[2])).Normal()
+		normal += p.impulse[0]
+	}
+
+	if friction == 0 || friction < s.material.StaticFriction*normal*(1-slideTolerance) {
+		return
+	}
+
+	for i := range s.points {
+		p := &s.points[i]
+		f := s.tangents[0].Scale(p.impulse[1]).Add(s.tangents[1].Scale(p.impulse[2]))
+		s.setFriction(p, f.Normalize().Scale(s.material.KineticFriction*p.impulse[0]), delta)
+	}
+}
+
+// setFriction apply difference between new and accumulated friction impulse of point
+func (s *contactSolver) setFriction(p *pointSolver, friction shapes.Point, delta float64) {
+	old := s.tangents[0].Scale(p.impulse[1]).Add(s.tangents[1].Scale(p.impulse[2]))
+	p.impulse[1], p.impulse[2] = friction.Dot(s.tangents[0]), friction.Dot(s.tangents[1])
+	s.applyImpulse(friction.Subtract(old), *p, delta)
+}
+
+// correct push objects apart by share of penetration
+func (s *contactSolver) correct() {
+	ia, ib := s.A.inverseMass(), s.B.inverseMass()
+	correction := s.Normal.Scale(max(s.Depth-correctionSlop, 0) / (ia + ib) * correctionPercent)
+
+	s.A.translate(correction.Scale(-ia))
+	s.B.translate(correction.Scale(ib))
+}
+
+// relativeVelocity return velocity of B relative to A in contact point
+func (s *contactSolver) relativeVelocity(p pointSolver, delta float64) shapes.Point {
+	pa, pb := s.A.center().Add(p.ra), s.B.center().Add(p.rb)
+	return s.B.velocityAt(pb, delta).Subtract(s.A.velocityAt(pa, delta))
+}
+
+// effectiveMass return inverse of impulse needed to change relative velocity along direction by one
+func (s *contactSolver) effectiveMass(direction, ra, rb shapes.Point) float64 {
+	angularA := s.A.inverseInertia().MultiplyPoint(ra.Cross(direction)).Cross(ra)
+	angularB := s.B.inverseInertia().MultiplyPoint(rb.Cross(direction)).Cross(rb)
+
+	return s.A.inverseMass() + s.B.inverseMass() + direction.Dot(angularA.Add(angularB))
+}
+
+// applyImpulse apply impulse to B and opposite impulse to A
+func (s *contactSolver) applyImpulse(impulse shapes.Point, p pointSolver, delta float64) {
+	s.A.addVelocity(impulse.Scale(-s.A.inverseMass()), s.A.inverseInertia().MultiplyPoint(p.ra.Cross(impulse.Invert())), delta)
+	s.B.addVelocity(impulse.Scale(s.B.inverseMass()), s.B.inverseInertia().MultiplyPoint(p.rb.Cross(impulse)), delta)
+}
+
+// ResolveContacts apply impulses with restitution and friction to contacts during given count of iterations,
+// switch slipping contacts to kinetic friction and push objects apart
+func ResolveContacts(contacts []Contact, delta float64, iterations int) {
+	solvers := make([]*contactSolver, len(contacts))
+	for i, c := range contacts {
+		solvers[i] = newContactSolver(c, delta)
+	}
+
+	for range iterations {
+		for _, s := range solvers {
+			s.solve(delta)
+		}
+	}
+
+	for _, s := range solvers {
+		s.slide(delta)
+		s.correct()
+	}
+}
+
+// resolveCollisions find and resolve contacts
+func (w *World) resolveCollisions(dimensions int) {
+	iterations := w.Iterations
+	if iterations < 1 {
+		iterations = DefaultCollisionIterations
+	}
+
+	ResolveContacts(w.Contacts(dimensions), w.Delta, iterations)
+}
@@ -7,8 +7,10 @@ type World struct {
 	shapes.Border
 	Gravity    shapes.Point
 	Composites []*Composite
+	Bodies     []*RigidBody
 	Step       float64
 	Delta      float64 // Delta time (1.0 / time Step)
+	Iterations int     // collision solver iterations per step, DefaultCollisionIterations if zero
 }
 
 // NewWorld return new world with gravity
@@ -44,6 +46,14 @@ func (w *World) Simulate(steps, dimensions int) {
 				c.Relax()
 			}
 		}
+
+		for _, b := range w.Bodies {
+			b.Accelerate(w.Gravity)
+			b.Simulate(w.Delta)
+			b.Restrain(w.Border, dimensions)
+		}
+
+		w.resolveCollisions(dimensions)
 	}
 }
 
@@ -51,3 +61,8 @@ func (w *World) Simulate(steps, dimensions int) {
 func (w *World) AddComposites(c ...*Composite) {
 	w.Composites = append(w.Composites, c...)
 }
+
+// AddBodies add rigid bodies
+func (w *World) AddBodies(b ...*RigidBody) {
+	w.Bodies = append(w.Bodies, b...)
+}